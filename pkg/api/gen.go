@@ -47,6 +47,24 @@ func (e BulkUpdateMode) Valid() bool {
 	}
 }
 
+// Defines values for ListItemsParamsSearchMode.
+const (
+	Boolean ListItemsParamsSearchMode = "boolean"
+	Natural ListItemsParamsSearchMode = "natural"
+)
+
+// Valid indicates whether the value is a known member of the ListItemsParamsSearchMode enum.
+func (e ListItemsParamsSearchMode) Valid() bool {
+	switch e {
+	case Boolean:
+		return true
+	case Natural:
+		return true
+	default:
+		return false
+	}
+}
+
 // BulkUpdateMode Mode of update:
 //   - `INSERT` - Objects are inserted. Conflicts will cause an error.
 //   - `REPLACE` - Objects are removed prior to creating if they exists.
@@ -71,6 +89,39 @@ type BulkUpdateRequest struct {
 	Objects []UntypedDto `json:"objects"`
 }
 
+// ColumnInfo Column of result set or entity
+type ColumnInfo struct {
+	Name     string `json:"name"`
+	Nullable *bool  `json:"nullable,omitempty"`
+
+	// Type Database type name, such as VARCHAR or INT
+	Type string `json:"type"`
+}
+
+// CommandResult Outcome of named command
+type CommandResult struct {
+	// AffectedRows Total number of rows affected by all statements
+	AffectedRows int `json:"affected_rows"`
+
+	// Out Unstructured content, dictionary of string-to-any values.
+	Out        *UntypedDto       `json:"out,omitempty"`
+	Statements []StatementResult `json:"statements"`
+}
+
+// EntitySchema Schema of entity
+type EntitySchema struct {
+	Columns     []SchemaColumn `json:"columns"`
+	ForeignKeys []ForeignKey   `json:"foreign_keys"`
+
+	// IdColumn Column used as ID of entity items by API
+	IdColumn string `json:"id_column"`
+	Name     string `json:"name"`
+
+	// PrimaryKey Columns of primary key
+	PrimaryKey []string    `json:"primary_key"`
+	UniqueKeys []UniqueKey `json:"unique_keys"`
+}
+
 // ErrorObject Generic object to convey error details
 type ErrorObject struct {
 	// Code Code related to error state
@@ -83,6 +134,16 @@ type ErrorObject struct {
 	Message string `json:"message"`
 }
 
+// ForeignKey Foreign key of entity
+type ForeignKey struct {
+	Columns    []string `json:"columns"`
+	Name       string   `json:"name"`
+	RefColumns []string `json:"ref_columns"`
+
+	// RefEntity Referenced entity
+	RefEntity string `json:"ref_entity"`
+}
+
 // NameList List of names, such as backends or entities
 type NameList = []string
 
@@ -97,6 +158,83 @@ type PagedResult struct {
 	TotalCount *int `json:"total_count,omitempty"`
 }
 
+// QueryInfo Description of named query
+type QueryInfo struct {
+	// Columns Columns of result set
+	Columns     *[]ColumnInfo    `json:"columns,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Name        string           `json:"name"`
+	Params      []QueryParamInfo `json:"params"`
+
+	// Permissions Permissions of caller on named query
+	Permissions QueryPermissions `json:"permissions"`
+}
+
+// QueryParamInfo Declaration of named parameter
+type QueryParamInfo struct {
+	Default *string   `json:"default,omitempty"`
+	Enum    *[]string `json:"enum,omitempty"`
+
+	// ItemType Type of list items
+	ItemType *string  `json:"item_type,omitempty"`
+	Max      *float32 `json:"max,omitempty"`
+	Min      *float32 `json:"min,omitempty"`
+	Name     string   `json:"name"`
+	Pattern  *string  `json:"pattern,omitempty"`
+	Required bool     `json:"required"`
+
+	// Type One of int, decimal, string, date, bool or list
+	Type string `json:"type"`
+}
+
+// QueryPermissions Permissions of caller on named query
+type QueryPermissions struct {
+	Execute bool `json:"execute"`
+}
+
+// SchemaColumn Column of entity
+type SchemaColumn struct {
+	AutoIncrement bool `json:"auto_increment"`
+
+	// ColumnType Full type definition, such as varchar(64) or int unsigned
+	ColumnType string `json:"column_type"`
+
+	// Default Default value as reported by database
+	Default *string `json:"default,omitempty"`
+
+	// MaxLength Maximal length of character or binary column
+	MaxLength *int64 `json:"max_length,omitempty"`
+	Name      string `json:"name"`
+	Nullable  bool   `json:"nullable"`
+
+	// Precision Precision of numeric column
+	Precision *int `json:"precision,omitempty"`
+
+	// ReadOnly Value is managed by bridge, values supplied in payloads are ignored
+	ReadOnly *bool `json:"read_only,omitempty"`
+
+	// Scale Scale of numeric column
+	Scale *int `json:"scale,omitempty"`
+
+	// Type Database type name, such as VARCHAR or INT
+	Type string `json:"type"`
+}
+
+// StatementResult Outcome of single statement of named command
+type StatementResult struct {
+	// AffectedRows Number of rows affected by statement
+	AffectedRows int `json:"affected_rows"`
+
+	// ResultSets Result sets returned by statement, such as by stored procedure
+	ResultSets *[][]UntypedDto `json:"result_sets,omitempty"`
+}
+
+// UniqueKey Unique key of entity
+type UniqueKey struct {
+	Columns []string `json:"columns"`
+	Name    string   `json:"name"`
+}
+
 // UntypedDto Unstructured content, dictionary of string-to-any values.
 type UntypedDto map[string]interface{}
 
@@ -106,12 +244,27 @@ type Backend = string
 // Entity defines model for entity.
 type Entity = string
 
+// Filter defines model for filter.
+type Filter = string
+
+// IncludeDeleted defines model for include-deleted.
+type IncludeDeleted = bool
+
+// OnlyDeleted defines model for only-deleted.
+type OnlyDeleted = bool
+
+// Order defines model for order.
+type Order = []string
+
 // PageOffset defines model for page-offset.
 type PageOffset = int
 
 // PageSize defines model for page-size.
 type PageSize = int
 
+// Q defines model for q.
+type Q = string
+
 // QueryNamedParams defines parameters for QueryNamed.
 type QueryNamedParams struct {
 	// PageOffset Page offset
@@ -120,7 +273,52 @@ type QueryNamedParams struct {
 	// PageSize Page size
 	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
 
-	// Arg Additional arguments passed to query
+	// Order List of order instructions in form of `key=direction`.
+	// Key represents entity field (column) and direction is one of `ASC` or `DESC`,
+	// for example `name=ASC` or `id=DESC`.
+	// Direction can be omitted, in such case `ASC` is assumed.
+	Order *Order `form:"order[],omitempty" json:"order[],omitempty"`
+
+	// Filter Filter is JSON-encoded FilterExpression.
+	// Supported types are `simple`, `not`, `junction`, `in`, `un`, `between` and `json`.
+	// Operators of `simple` and `json` are `=`, `<>`, `!=`, `>`, `>=`, `<`, `<=`, `LIKE`, `NOT LIKE`, `ILIKE`,
+	// `REGEXP`, `NOT REGEXP`, `contains`, `startsWith` and `endsWith`. Last three match literal text,
+	// wildcards in value are escaped. Operator of `in` is either `IN` (default) or `NOT IN`.
+	// Examples:
+	//
+	// - `{"simple": { "name": "id", "op": "=", "val" : 1}}`
+	//
+	//    is equivalent to SQL `id=1`
+	//
+	// - `{"not": { "simple": { "name": "id", "op": ">", "val" : 100}}}`
+	//
+	//    is equivalent to SQL `NOT (id>100)`
+	//
+	// - `{"junction": {"op": "AND", "sub" : [{"simple": { "name": "age", "op": ">", "val" : 35}}, {"simple": { "name": "salary", "op": ">", "val" : 5000}}]}}`
+	//
+	//    is equivalent to SQL `(age>35) AND (salary > 5000)`
+	//
+	// - `{"in": { "name": "dept", "op": "NOT IN", "val": ["HR", "IT"]}}`
+	//
+	//    is equivalent to SQL `dept NOT IN ('HR', 'IT')`
+	//
+	// - `{"json": { "name": "attrs", "path": "$.address.city", "op": "startsWith", "val": "Bra"}}`
+	//
+	//    is equivalent to SQL `attrs->>'$.address.city' LIKE 'Bra%'`
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Q Filter in RSQL syntax, alternative to `filter` parameter, both can't be used at once.
+	// Constraints are joined by `;` (AND) and `,` (OR), AND binds tighter than OR and parentheses can group them.
+	// Comparison operators are `==`, `!=`, `=gt=` (`>`), `=ge=` (`>=`), `=lt=` (`<`), `=le=` (`<=`), `=in=`, `=out=`,
+	// `=like=`, `=notlike=`, `=ilike=`, `=regex=`, `=notregex=`, `=contains=`, `=startswith=`, `=endswith=`,
+	// `=between=` and `=isnull=`. Selector can have path within JSON column appended, like `attrs.address.city`.
+	// Values that contain reserved characters must be quoted.
+	// Example: `name==Bob;salary=gt=1000,department=in=(IT,HR)`
+	// is equivalent to SQL `(name = 'Bob' AND salary > 1000) OR department IN ('IT', 'HR')`.
+	// Parse errors are reported with `400`, position of problem is in `data.position`.
+	Q *Q `form:"q,omitempty" json:"q,omitempty"`
+
+	// Arg Additional positional arguments passed to query that does not declare named parameters
 	Arg *[]string `form:"arg,omitempty" json:"arg,omitempty"`
 }
 
@@ -136,10 +334,74 @@ type ListItemsParams struct {
 	// Key represents entity field (column) and direction is one of `ASC` or `DESC`,
 	// for example `name=ASC` or `id=DESC`.
 	// Direction can be omitted, in such case `ASC` is assumed.
-	Order *[]string `form:"order[],omitempty" json:"order[],omitempty"`
+	Order *Order `form:"order[],omitempty" json:"order[],omitempty"`
+
+	// Filter Filter is JSON-encoded FilterExpression.
+	// Supported types are `simple`, `not`, `junction`, `in`, `un`, `between` and `json`.
+	// Operators of `simple` and `json` are `=`, `<>`, `!=`, `>`, `>=`, `<`, `<=`, `LIKE`, `NOT LIKE`, `ILIKE`,
+	// `REGEXP`, `NOT REGEXP`, `contains`, `startsWith` and `endsWith`. Last three match literal text,
+	// wildcards in value are escaped. Operator of `in` is either `IN` (default) or `NOT IN`.
+	// Examples:
+	//
+	// - `{"simple": { "name": "id", "op": "=", "val" : 1}}`
+	//
+	//    is equivalent to SQL `id=1`
+	//
+	// - `{"not": { "simple": { "name": "id", "op": ">", "val" : 100}}}`
+	//
+	//    is equivalent to SQL `NOT (id>100)`
+	//
+	// - `{"junction": {"op": "AND", "sub" : [{"simple": { "name": "age", "op": ">", "val" : 35}}, {"simple": { "name": "salary", "op": ">", "val" : 5000}}]}}`
+	//
+	//    is equivalent to SQL `(age>35) AND (salary > 5000)`
+	//
+	// - `{"in": { "name": "dept", "op": "NOT IN", "val": ["HR", "IT"]}}`
+	//
+	//    is equivalent to SQL `dept NOT IN ('HR', 'IT')`
+	//
+	// - `{"json": { "name": "attrs", "path": "$.address.city", "op": "startsWith", "val": "Bra"}}`
+	//
+	//    is equivalent to SQL `attrs->>'$.address.city' LIKE 'Bra%'`
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Q Filter in RSQL syntax, alternative to `filter` parameter, both can't be used at once.
+	// Constraints are joined by `;` (AND) and `,` (OR), AND binds tighter than OR and parentheses can group them.
+	// Comparison operators are `==`, `!=`, `=gt=` (`>`), `=ge=` (`>=`), `=lt=` (`<`), `=le=` (`<=`), `=in=`, `=out=`,
+	// `=like=`, `=notlike=`, `=ilike=`, `=regex=`, `=notregex=`, `=contains=`, `=startswith=`, `=endswith=`,
+	// `=between=` and `=isnull=`. Selector can have path within JSON column appended, like `attrs.address.city`.
+	// Values that contain reserved characters must be quoted.
+	// Example: `name==Bob;salary=gt=1000,department=in=(IT,HR)`
+	// is equivalent to SQL `(name = 'Bob' AND salary > 1000) OR department IN ('IT', 'HR')`.
+	// Parse errors are reported with `400`, position of problem is in `data.position`.
+	Q *Q `form:"q,omitempty" json:"q,omitempty"`
+
+	// Search Full-text search within columns configured for entity, combined with filter.
+	// Items can be ordered by relevance using pseudo-column `_score`, such as `order[]=_score=desc`.
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
+	// SearchMode Mode of full-text search, defaults to mode configured for entity.
+	SearchMode *ListItemsParamsSearchMode `form:"search-mode,omitempty" json:"search-mode,omitempty"`
+
+	// SearchScore When true, relevance of every item is returned in `_score` property.
+	SearchScore *bool `form:"search-score,omitempty" json:"search-score,omitempty"`
+
+	// IncludeDeleted Include soft-deleted items, when entity has soft delete configured.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// OnlyDeleted Show only soft-deleted items, when entity has soft delete configured.
+	OnlyDeleted *OnlyDeleted `form:"only_deleted,omitempty" json:"only_deleted,omitempty"`
+}
+
+// ListItemsParamsSearchMode defines parameters for ListItems.
+type ListItemsParamsSearchMode string
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
 	// Filter Filter is JSON-encoded FilterExpression.
-	// Currently supported types are `simple`, `not` and `junction`.
+	// Supported types are `simple`, `not`, `junction`, `in`, `un`, `between` and `json`.
+	// Operators of `simple` and `json` are `=`, `<>`, `!=`, `>`, `>=`, `<`, `<=`, `LIKE`, `NOT LIKE`, `ILIKE`,
+	// `REGEXP`, `NOT REGEXP`, `contains`, `startsWith` and `endsWith`. Last three match literal text,
+	// wildcards in value are escaped. Operator of `in` is either `IN` (default) or `NOT IN`.
 	// Examples:
 	//
 	// - `{"simple": { "name": "id", "op": "=", "val" : 1}}`
@@ -153,9 +415,61 @@ type ListItemsParams struct {
 	// - `{"junction": {"op": "AND", "sub" : [{"simple": { "name": "age", "op": ">", "val" : 35}}, {"simple": { "name": "salary", "op": ">", "val" : 5000}}]}}`
 	//
 	//    is equivalent to SQL `(age>35) AND (salary > 5000)`
-	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+	//
+	// - `{"in": { "name": "dept", "op": "NOT IN", "val": ["HR", "IT"]}}`
+	//
+	//    is equivalent to SQL `dept NOT IN ('HR', 'IT')`
+	//
+	// - `{"json": { "name": "attrs", "path": "$.address.city", "op": "startsWith", "val": "Bra"}}`
+	//
+	//    is equivalent to SQL `attrs->>'$.address.city' LIKE 'Bra%'`
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Q Filter in RSQL syntax, alternative to `filter` parameter, both can't be used at once.
+	// Constraints are joined by `;` (AND) and `,` (OR), AND binds tighter than OR and parentheses can group them.
+	// Comparison operators are `==`, `!=`, `=gt=` (`>`), `=ge=` (`>=`), `=lt=` (`<`), `=le=` (`<=`), `=in=`, `=out=`,
+	// `=like=`, `=notlike=`, `=ilike=`, `=regex=`, `=notregex=`, `=contains=`, `=startswith=`, `=endswith=`,
+	// `=between=` and `=isnull=`. Selector can have path within JSON column appended, like `attrs.address.city`.
+	// Values that contain reserved characters must be quoted.
+	// Example: `name==Bob;salary=gt=1000,department=in=(IT,HR)`
+	// is equivalent to SQL `(name = 'Bob' AND salary > 1000) OR department IN ('IT', 'HR')`.
+	// Parse errors are reported with `400`, position of problem is in `data.position`.
+	Q *Q `form:"q,omitempty" json:"q,omitempty"`
+
+	// Since Alternative to Last-Event-ID header, for clients that can't set headers.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// LastEventID Resume after event with this ID, as long as it's still buffered.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// DeleteItemByIdParams defines parameters for DeleteItemById.
+type DeleteItemByIdParams struct {
+	// Purge Remove item physically, even if entity has soft delete. Requires `purge` permission.
+	Purge *bool `form:"purge,omitempty" json:"purge,omitempty"`
+}
+
+// GetItemByIdParams defines parameters for GetItemById.
+type GetItemByIdParams struct {
+	// IncludeDeleted Include soft-deleted items, when entity has soft delete configured.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// OnlyDeleted Show only soft-deleted items, when entity has soft delete configured.
+	OnlyDeleted *OnlyDeleted `form:"only_deleted,omitempty" json:"only_deleted,omitempty"`
+}
+
+// ExistsItemByIdParams defines parameters for ExistsItemById.
+type ExistsItemByIdParams struct {
+	// IncludeDeleted Include soft-deleted items, when entity has soft delete configured.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// OnlyDeleted Show only soft-deleted items, when entity has soft delete configured.
+	OnlyDeleted *OnlyDeleted `form:"only_deleted,omitempty" json:"only_deleted,omitempty"`
 }
 
+// ExecCommandJSONRequestBody defines body for ExecCommand for application/json ContentType.
+type ExecCommandJSONRequestBody = UntypedDto
+
 // CreateItemJSONRequestBody defines body for CreateItem for application/json ContentType.
 type CreateItemJSONRequestBody = UntypedDto
 
@@ -253,11 +567,55 @@ type ClientInterface interface {
 	// Corresponds with GET /version (the `GetVersionInfo` operationId).
 	GetVersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FlushCache Flush response cache
+	//
+	// Drop all cached responses of named queries and entities within backend.
+	// Caller must be granted `admin` operation on backend.
+	//
+	// Corresponds with DELETE /{backend}/_cache (the `FlushCache` operationId).
+	FlushCache(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecCommandWithBody Execute named command
+	//
+	// Run previously-crafted write command on the server.
+	// All statements of command are executed in single transaction.
+	// Request body holds values of named parameters declared by command.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+	ExecCommandWithBody(ctx context.Context, backend Backend, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecCommand Execute named command
+	//
+	// Run previously-crafted write command on the server.
+	// All statements of command are executed in single transaction.
+	// Request body holds values of named parameters declared by command.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+	ExecCommand(ctx context.Context, backend Backend, name string, body ExecCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListQueries List named queries
+	//
+	// List named queries configured within backend that caller is allowed to run, along with their parameters,
+	// columns of result set and permissions of caller.
+	// Columns are determined by running query with LIMIT 0, they are omitted when that is not possible.
+	//
+	// Corresponds with GET /{backend}/_query (the `ListQueries` operationId).
+	ListQueries(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryNamed Execute named query and return the result set
 	//
 	// Run previously-crafted query on the server and return results.
 	// Saved queries should not contain any paging statement as those are provided
 	// based on `page-offset` and `page-size` parameter.
+	// Result set can be filtered and ordered same way as list of entity items,
+	// columns are validated against result set of query.
+	// Named parameters declared by query are passed as `p.<name>=<value>`, list parameters
+	// either as repeated values or as comma-separated value.
+	// All invalid parameters are reported at once with status code 400.
 	//
 	// Corresponds with GET /{backend}/_query/{name} (the `QueryNamed` operationId).
 	QueryNamed(ctx context.Context, backend Backend, name string, params *QueryNamedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -290,6 +648,23 @@ type ClientInterface interface {
 	// Corresponds with POST /{backend}/{entity} (the `CreateItem` operationId).
 	CreateItem(ctx context.Context, backend Backend, entity Entity, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamEvents Stream changes of entity items
+	//
+	// Stream change events of entity items as Server-Sent Events, or as WebSocket messages when request
+	// asks for upgrade. Only writes made through this bridge are streamed, and only items that principal
+	// is allowed to read. Heartbeat is sent periodically. Events of delete carry item before change in `old`,
+	// other events carry item after change in `new`.
+	//
+	// Corresponds with GET /{backend}/{entity}/_events (the `StreamEvents` operationId).
+	StreamEvents(ctx context.Context, backend Backend, entity Entity, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEntitySchema Get schema of entity
+	//
+	// Get columns, keys and foreign keys of entity, as reported by information_schema of database.
+	//
+	// Corresponds with GET /{backend}/{entity}/_schema (the `GetEntitySchema` operationId).
+	GetEntitySchema(ctx context.Context, backend Backend, entity Entity, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// BulkUpdateWithBody Perform bulk update
 	//
 	// Takes any type of body and a specified content type.
@@ -306,18 +681,20 @@ type ClientInterface interface {
 
 	// DeleteItemById Delete entity item by ID
 	//
+	// When entity has soft delete configured, item is only marked as deleted, unless `purge` is requested.
+	//
 	// Corresponds with DELETE /{backend}/{entity}/{id} (the `DeleteItemById` operationId).
-	DeleteItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteItemById(ctx context.Context, backend Backend, entity Entity, id string, params *DeleteItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetItemById Get entity item by ID
 	//
 	// Corresponds with GET /{backend}/{entity}/{id} (the `GetItemById` operationId).
-	GetItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetItemById(ctx context.Context, backend Backend, entity Entity, id string, params *GetItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ExistsItemById Check for existence of entity item by ID
 	//
 	// Corresponds with HEAD /{backend}/{entity}/{id} (the `ExistsItemById` operationId).
-	ExistsItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ExistsItemById(ctx context.Context, backend Backend, entity Entity, id string, params *ExistsItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateItemByIdWithBody Update entity item in-place by ID
 	//
@@ -332,6 +709,30 @@ type ClientInterface interface {
 	//
 	// Corresponds with PUT /{backend}/{entity}/{id} (the `UpdateItemById` operationId).
 	UpdateItemById(ctx context.Context, backend Backend, entity Entity, id string, body UpdateItemByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBlob Download content of binary column
+	//
+	// Stream raw content of binary column of entity item.
+	// Content type is either configured for column, read from another column or sniffed from content.
+	// Single and multiple byte ranges are supported via `Range` header.
+	//
+	// Corresponds with GET /{backend}/{entity}/{id}/_blob/{column} (the `GetBlob` operationId).
+	GetBlob(ctx context.Context, backend Backend, entity Entity, id string, column string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBlobWithBody Upload content of binary column
+	//
+	// Replace content of binary column of entity item with raw request body.
+	// Value of `Content-Type` header is stored in content type column, when one is configured.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /{backend}/{entity}/{id}/_blob/{column} (the `PutBlob` operationId).
+	PutBlobWithBody(ctx context.Context, backend Backend, entity Entity, id string, column string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreItem Restore soft-deleted entity item by ID
+	//
+	// Corresponds with POST /{backend}/{entity}/{id}/_restore (the `RestoreItem` operationId).
+	RestoreItem(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 // ListBackends List all configured backends
@@ -368,11 +769,95 @@ func (c *Client) GetVersionInfo(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
+// FlushCache Flush response cache
+//
+// Drop all cached responses of named queries and entities within backend.
+// Caller must be granted `admin` operation on backend.
+//
+// Corresponds with DELETE /{backend}/_cache (the `FlushCache` operationId).
+func (c *Client) FlushCache(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFlushCacheRequest(c.Server, backend)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ExecCommandWithBody Execute named command
+//
+// Run previously-crafted write command on the server.
+// All statements of command are executed in single transaction.
+// Request body holds values of named parameters declared by command.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+func (c *Client) ExecCommandWithBody(ctx context.Context, backend Backend, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecCommandRequestWithBody(c.Server, backend, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ExecCommand Execute named command
+//
+// Run previously-crafted write command on the server.
+// All statements of command are executed in single transaction.
+// Request body holds values of named parameters declared by command.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+func (c *Client) ExecCommand(ctx context.Context, backend Backend, name string, body ExecCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecCommandRequest(c.Server, backend, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListQueries List named queries
+//
+// List named queries configured within backend that caller is allowed to run, along with their parameters,
+// columns of result set and permissions of caller.
+// Columns are determined by running query with LIMIT 0, they are omitted when that is not possible.
+//
+// Corresponds with GET /{backend}/_query (the `ListQueries` operationId).
+func (c *Client) ListQueries(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListQueriesRequest(c.Server, backend)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // QueryNamed Execute named query and return the result set
 //
 // Run previously-crafted query on the server and return results.
 // Saved queries should not contain any paging statement as those are provided
 // based on `page-offset` and `page-size` parameter.
+// Result set can be filtered and ordered same way as list of entity items,
+// columns are validated against result set of query.
+// Named parameters declared by query are passed as `p.<name>=<value>`, list parameters
+// either as repeated values or as comma-separated value.
+// All invalid parameters are reported at once with status code 400.
 //
 // Corresponds with GET /{backend}/_query/{name} (the `QueryNamed` operationId).
 func (c *Client) QueryNamed(ctx context.Context, backend Backend, name string, params *QueryNamedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -455,6 +940,43 @@ func (c *Client) CreateItem(ctx context.Context, backend Backend, entity Entity,
 	return c.Client.Do(req)
 }
 
+// StreamEvents Stream changes of entity items
+//
+// Stream change events of entity items as Server-Sent Events, or as WebSocket messages when request
+// asks for upgrade. Only writes made through this bridge are streamed, and only items that principal
+// is allowed to read. Heartbeat is sent periodically. Events of delete carry item before change in `old`,
+// other events carry item after change in `new`.
+//
+// Corresponds with GET /{backend}/{entity}/_events (the `StreamEvents` operationId).
+func (c *Client) StreamEvents(ctx context.Context, backend Backend, entity Entity, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, backend, entity, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetEntitySchema Get schema of entity
+//
+// Get columns, keys and foreign keys of entity, as reported by information_schema of database.
+//
+// Corresponds with GET /{backend}/{entity}/_schema (the `GetEntitySchema` operationId).
+func (c *Client) GetEntitySchema(ctx context.Context, backend Backend, entity Entity, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEntitySchemaRequest(c.Server, backend, entity)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // BulkUpdateWithBody Perform bulk update
 //
 // Takes any type of body and a specified content type.
@@ -491,9 +1013,11 @@ func (c *Client) BulkUpdate(ctx context.Context, backend Backend, entity Entity,
 
 // DeleteItemById Delete entity item by ID
 //
+// When entity has soft delete configured, item is only marked as deleted, unless `purge` is requested.
+//
 // Corresponds with DELETE /{backend}/{entity}/{id} (the `DeleteItemById` operationId).
-func (c *Client) DeleteItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteItemByIdRequest(c.Server, backend, entity, id)
+func (c *Client) DeleteItemById(ctx context.Context, backend Backend, entity Entity, id string, params *DeleteItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteItemByIdRequest(c.Server, backend, entity, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -507,8 +1031,8 @@ func (c *Client) DeleteItemById(ctx context.Context, backend Backend, entity Ent
 // GetItemById Get entity item by ID
 //
 // Corresponds with GET /{backend}/{entity}/{id} (the `GetItemById` operationId).
-func (c *Client) GetItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetItemByIdRequest(c.Server, backend, entity, id)
+func (c *Client) GetItemById(ctx context.Context, backend Backend, entity Entity, id string, params *GetItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetItemByIdRequest(c.Server, backend, entity, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -522,8 +1046,8 @@ func (c *Client) GetItemById(ctx context.Context, backend Backend, entity Entity
 // ExistsItemById Check for existence of entity item by ID
 //
 // Corresponds with HEAD /{backend}/{entity}/{id} (the `ExistsItemById` operationId).
-func (c *Client) ExistsItemById(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewExistsItemByIdRequest(c.Server, backend, entity, id)
+func (c *Client) ExistsItemById(ctx context.Context, backend Backend, entity Entity, id string, params *ExistsItemByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExistsItemByIdRequest(c.Server, backend, entity, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -568,27 +1092,81 @@ func (c *Client) UpdateItemById(ctx context.Context, backend Backend, entity Ent
 	return c.Client.Do(req)
 }
 
-// NewListBackendsRequest constructs an http.Request for the ListBackends method
-func NewListBackendsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// GetBlob Download content of binary column
+//
+// Stream raw content of binary column of entity item.
+// Content type is either configured for column, read from another column or sniffed from content.
+// Single and multiple byte ranges are supported via `Range` header.
+//
+// Corresponds with GET /{backend}/{entity}/{id}/_blob/{column} (the `GetBlob` operationId).
+func (c *Client) GetBlob(ctx context.Context, backend Backend, entity Entity, id string, column string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBlobRequest(c.Server, backend, entity, id, column)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backends")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// PutBlobWithBody Upload content of binary column
+//
+// Replace content of binary column of entity item with raw request body.
+// Value of `Content-Type` header is stored in content type column, when one is configured.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /{backend}/{entity}/{id}/_blob/{column} (the `PutBlob` operationId).
+func (c *Client) PutBlobWithBody(ctx context.Context, backend Backend, entity Entity, id string, column string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBlobRequestWithBody(c.Server, backend, entity, id, column, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RestoreItem Restore soft-deleted entity item by ID
+//
+// Corresponds with POST /{backend}/{entity}/{id}/_restore (the `RestoreItem` operationId).
+func (c *Client) RestoreItem(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreItemRequest(c.Server, backend, entity, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListBackendsRequest constructs an http.Request for the ListBackends method
+func NewListBackendsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backends")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -622,6 +1200,128 @@ func NewGetVersionInfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewFlushCacheRequest constructs an http.Request for the FlushCache method
+func NewFlushCacheRequest(server string, backend Backend) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/_cache", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExecCommandRequest calls the generic ExecCommand builder with application/json body
+func NewExecCommandRequest(server string, backend Backend, name string, body ExecCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExecCommandRequestWithBody(server, backend, name, "application/json", bodyReader)
+}
+
+// NewExecCommandRequestWithBody constructs an http.Request for the ExecCommand method, with any body, and a specified content type
+func NewExecCommandRequestWithBody(server string, backend Backend, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/_command/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListQueriesRequest constructs an http.Request for the ListQueries method
+func NewListQueriesRequest(server string, backend Backend) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/_query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewQueryNamedRequest constructs an http.Request for the QueryNamed method
 func NewQueryNamedRequest(server string, backend Backend, name string, params *QueryNamedParams) (*http.Request, error) {
 	var err error
@@ -688,6 +1388,42 @@ func NewQueryNamedRequest(server string, backend Backend, name string, params *Q
 
 		}
 
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order[]", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "q", *params.Q, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if params.Arg != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "arg", *params.Arg, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
@@ -838,6 +1574,78 @@ func NewListItemsRequest(server string, backend Backend, entity Entity, params *
 
 		}
 
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "q", *params.Q, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SearchMode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search-mode", *params.SearchMode, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SearchScore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search-score", *params.SearchScore, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_deleted", *params.IncludeDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.OnlyDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "only_deleted", *params.OnlyDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -906,19 +1714,8 @@ func NewCreateItemRequestWithBody(server string, backend Backend, entity Entity,
 	return req, nil
 }
 
-// NewBulkUpdateRequest calls the generic BulkUpdate builder with application/json body
-func NewBulkUpdateRequest(server string, backend Backend, entity Entity, body BulkUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewBulkUpdateRequestWithBody(server, backend, entity, "application/json", bodyReader)
-}
-
-// NewBulkUpdateRequestWithBody constructs an http.Request for the BulkUpdate method, with any body, and a specified content type
-func NewBulkUpdateRequestWithBody(server string, backend Backend, entity Entity, contentType string, body io.Reader) (*http.Request, error) {
+// NewStreamEventsRequest constructs an http.Request for the StreamEvents method
+func NewStreamEventsRequest(server string, backend Backend, entity Entity, params *StreamEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -940,7 +1737,7 @@ func NewBulkUpdateRequestWithBody(server string, backend Backend, entity Entity,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/%s/%s/bulk", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/%s/%s/_events", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -950,9 +1747,168 @@ func NewBulkUpdateRequestWithBody(server string, backend Backend, entity Entity,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "q", *params.Q, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "Last-Event-ID", *params.LastEventID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEntitySchemaRequest constructs an http.Request for the GetEntitySchema method
+func NewGetEntitySchemaRequest(server string, backend Backend, entity Entity) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "entity", entity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/_schema", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBulkUpdateRequest calls the generic BulkUpdate builder with application/json body
+func NewBulkUpdateRequest(server string, backend Backend, entity Entity, body BulkUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkUpdateRequestWithBody(server, backend, entity, "application/json", bodyReader)
+}
+
+// NewBulkUpdateRequestWithBody constructs an http.Request for the BulkUpdate method, with any body, and a specified content type
+func NewBulkUpdateRequestWithBody(server string, backend Backend, entity Entity, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "entity", entity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/bulk", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
 	req.Header.Add("Content-Type", contentType)
@@ -961,7 +1917,7 @@ func NewBulkUpdateRequestWithBody(server string, backend Backend, entity Entity,
 }
 
 // NewDeleteItemByIdRequest constructs an http.Request for the DeleteItemById method
-func NewDeleteItemByIdRequest(server string, backend Backend, entity Entity, id string) (*http.Request, error) {
+func NewDeleteItemByIdRequest(server string, backend Backend, entity Entity, id string, params *DeleteItemByIdParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1000,6 +1956,33 @@ func NewDeleteItemByIdRequest(server string, backend Backend, entity Entity, id
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Purge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "purge", *params.Purge, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1009,7 +1992,7 @@ func NewDeleteItemByIdRequest(server string, backend Backend, entity Entity, id
 }
 
 // NewGetItemByIdRequest constructs an http.Request for the GetItemById method
-func NewGetItemByIdRequest(server string, backend Backend, entity Entity, id string) (*http.Request, error) {
+func NewGetItemByIdRequest(server string, backend Backend, entity Entity, id string, params *GetItemByIdParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1048,6 +2031,45 @@ func NewGetItemByIdRequest(server string, backend Backend, entity Entity, id str
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_deleted", *params.IncludeDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.OnlyDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "only_deleted", *params.OnlyDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1057,7 +2079,7 @@ func NewGetItemByIdRequest(server string, backend Backend, entity Entity, id str
 }
 
 // NewExistsItemByIdRequest constructs an http.Request for the ExistsItemById method
-func NewExistsItemByIdRequest(server string, backend Backend, entity Entity, id string) (*http.Request, error) {
+func NewExistsItemByIdRequest(server string, backend Backend, entity Entity, id string, params *ExistsItemByIdParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1096,6 +2118,45 @@ func NewExistsItemByIdRequest(server string, backend Backend, entity Entity, id
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_deleted", *params.IncludeDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.OnlyDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "only_deleted", *params.OnlyDeleted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodHead, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1165,17 +2226,177 @@ func NewUpdateItemByIdRequestWithBody(server string, backend Backend, entity Ent
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
+// NewGetBlobRequest constructs an http.Request for the GetBlob method
+func NewGetBlobRequest(server string, backend Backend, entity Entity, id string, column string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "entity", entity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "column", column, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/_blob/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBlobRequestWithBody constructs an http.Request for the PutBlob method, with any body, and a specified content type
+func NewPutBlobRequestWithBody(server string, backend Backend, entity Entity, id string, column string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "entity", entity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "column", column, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/_blob/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreItemRequest constructs an http.Request for the RestoreItem method
+func NewRestoreItemRequest(server string, backend Backend, entity Entity, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "backend", backend, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "entity", entity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/_restore", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1227,11 +2448,59 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with GET /version (the `GetVersionInfo` operationId).
 	GetVersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionInfoResponse, error)
 
+	// FlushCacheWithResponse Flush response cache
+	//
+	// Drop all cached responses of named queries and entities within backend.
+	// Caller must be granted `admin` operation on backend.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /{backend}/_cache (the `FlushCache` operationId).
+	FlushCacheWithResponse(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*FlushCacheResponse, error)
+
+	// ExecCommandWithBodyWithResponse Execute named command
+	//
+	// Run previously-crafted write command on the server.
+	// All statements of command are executed in single transaction.
+	// Request body holds values of named parameters declared by command.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+	ExecCommandWithBodyWithResponse(ctx context.Context, backend Backend, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecCommandResponse, error)
+
+	// ExecCommandWithResponse Execute named command
+	//
+	// Run previously-crafted write command on the server.
+	// All statements of command are executed in single transaction.
+	// Request body holds values of named parameters declared by command.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+	ExecCommandWithResponse(ctx context.Context, backend Backend, name string, body ExecCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecCommandResponse, error)
+
+	// ListQueriesWithResponse List named queries
+	//
+	// List named queries configured within backend that caller is allowed to run, along with their parameters,
+	// columns of result set and permissions of caller.
+	// Columns are determined by running query with LIMIT 0, they are omitted when that is not possible.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /{backend}/_query (the `ListQueries` operationId).
+	ListQueriesWithResponse(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*ListQueriesResponse, error)
+
 	// QueryNamedWithResponse Execute named query and return the result set
 	//
 	// Run previously-crafted query on the server and return results.
 	// Saved queries should not contain any paging statement as those are provided
 	// based on `page-offset` and `page-size` parameter.
+	// Result set can be filtered and ordered same way as list of entity items,
+	// columns are validated against result set of query.
+	// Named parameters declared by query are passed as `p.<name>=<value>`, list parameters
+	// either as repeated values or as comma-separated value.
+	// All invalid parameters are reported at once with status code 400.
 	//
 	// Returns a wrapper object for the known response body format(s).
 	//
@@ -1270,6 +2539,27 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with POST /{backend}/{entity} (the `CreateItem` operationId).
 	CreateItemWithResponse(ctx context.Context, backend Backend, entity Entity, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateItemResponse, error)
 
+	// StreamEventsWithResponse Stream changes of entity items
+	//
+	// Stream change events of entity items as Server-Sent Events, or as WebSocket messages when request
+	// asks for upgrade. Only writes made through this bridge are streamed, and only items that principal
+	// is allowed to read. Heartbeat is sent periodically. Events of delete carry item before change in `old`,
+	// other events carry item after change in `new`.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /{backend}/{entity}/_events (the `StreamEvents` operationId).
+	StreamEventsWithResponse(ctx context.Context, backend Backend, entity Entity, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// GetEntitySchemaWithResponse Get schema of entity
+	//
+	// Get columns, keys and foreign keys of entity, as reported by information_schema of database.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /{backend}/{entity}/_schema (the `GetEntitySchema` operationId).
+	GetEntitySchemaWithResponse(ctx context.Context, backend Backend, entity Entity, reqEditors ...RequestEditorFn) (*GetEntitySchemaResponse, error)
+
 	// BulkUpdateWithBodyWithResponse Perform bulk update
 	//
 	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
@@ -1286,24 +2576,26 @@ type ClientWithResponsesInterface interface {
 
 	// DeleteItemByIdWithResponse Delete entity item by ID
 	//
+	// When entity has soft delete configured, item is only marked as deleted, unless `purge` is requested.
+	//
 	// Returns a wrapper object for the known response body format(s).
 	//
 	// Corresponds with DELETE /{backend}/{entity}/{id} (the `DeleteItemById` operationId).
-	DeleteItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*DeleteItemByIdResponse, error)
+	DeleteItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *DeleteItemByIdParams, reqEditors ...RequestEditorFn) (*DeleteItemByIdResponse, error)
 
 	// GetItemByIdWithResponse Get entity item by ID
 	//
 	// Returns a wrapper object for the known response body format(s).
 	//
 	// Corresponds with GET /{backend}/{entity}/{id} (the `GetItemById` operationId).
-	GetItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*GetItemByIdResponse, error)
+	GetItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *GetItemByIdParams, reqEditors ...RequestEditorFn) (*GetItemByIdResponse, error)
 
 	// ExistsItemByIdWithResponse Check for existence of entity item by ID
 	//
 	// Returns a wrapper object for the known response body format(s).
 	//
 	// Corresponds with HEAD /{backend}/{entity}/{id} (the `ExistsItemById` operationId).
-	ExistsItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*ExistsItemByIdResponse, error)
+	ExistsItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *ExistsItemByIdParams, reqEditors ...RequestEditorFn) (*ExistsItemByIdResponse, error)
 
 	// UpdateItemByIdWithBodyWithResponse Update entity item in-place by ID
 	//
@@ -1318,6 +2610,34 @@ type ClientWithResponsesInterface interface {
 	//
 	// Corresponds with PUT /{backend}/{entity}/{id} (the `UpdateItemById` operationId).
 	UpdateItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, body UpdateItemByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateItemByIdResponse, error)
+
+	// GetBlobWithResponse Download content of binary column
+	//
+	// Stream raw content of binary column of entity item.
+	// Content type is either configured for column, read from another column or sniffed from content.
+	// Single and multiple byte ranges are supported via `Range` header.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /{backend}/{entity}/{id}/_blob/{column} (the `GetBlob` operationId).
+	GetBlobWithResponse(ctx context.Context, backend Backend, entity Entity, id string, column string, reqEditors ...RequestEditorFn) (*GetBlobResponse, error)
+
+	// PutBlobWithBodyWithResponse Upload content of binary column
+	//
+	// Replace content of binary column of entity item with raw request body.
+	// Value of `Content-Type` header is stored in content type column, when one is configured.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /{backend}/{entity}/{id}/_blob/{column} (the `PutBlob` operationId).
+	PutBlobWithBodyWithResponse(ctx context.Context, backend Backend, entity Entity, id string, column string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBlobResponse, error)
+
+	// RestoreItemWithResponse Restore soft-deleted entity item by ID
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /{backend}/{entity}/{id}/_restore (the `RestoreItem` operationId).
+	RestoreItemWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error)
 }
 
 type ListBackendsResponse struct {
@@ -1402,32 +2722,25 @@ func (r GetVersionInfoResponse) ContentType() string {
 	return ""
 }
 
-type QueryNamedResponse struct {
+type FlushCacheResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *PagedResult
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *ErrorObject
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r QueryNamedResponse) GetJSON200() *PagedResult {
-	return r.JSON200
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *ErrorObject
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r QueryNamedResponse) GetJSON404() *ErrorObject {
-	return r.JSON404
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r FlushCacheResponse) GetJSON403() *ErrorObject {
+	return r.JSON403
 }
 
 // GetBody returns the raw response body bytes
-func (r QueryNamedResponse) GetBody() []byte {
+func (r FlushCacheResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r QueryNamedResponse) Status() string {
+func (r FlushCacheResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1435,7 +2748,7 @@ func (r QueryNamedResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QueryNamedResponse) StatusCode() int {
+func (r FlushCacheResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1443,32 +2756,46 @@ func (r QueryNamedResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r QueryNamedResponse) ContentType() string {
+func (r FlushCacheResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListEntitiesResponse struct {
+type ExecCommandResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *NameList
+	JSON200 *CommandResult
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ErrorObject
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorObject
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListEntitiesResponse) GetJSON200() *NameList {
+func (r ExecCommandResponse) GetJSON200() *CommandResult {
 	return r.JSON200
 }
 
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r ExecCommandResponse) GetJSON400() *ErrorObject {
+	return r.JSON400
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ExecCommandResponse) GetJSON404() *ErrorObject {
+	return r.JSON404
+}
+
 // GetBody returns the raw response body bytes
-func (r ListEntitiesResponse) GetBody() []byte {
+func (r ExecCommandResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListEntitiesResponse) Status() string {
+func (r ExecCommandResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1476,7 +2803,7 @@ func (r ListEntitiesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListEntitiesResponse) StatusCode() int {
+func (r ExecCommandResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1484,32 +2811,32 @@ func (r ListEntitiesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListEntitiesResponse) ContentType() string {
+func (r ExecCommandResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListItemsResponse struct {
+type ListQueriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *PagedResult
+	JSON200 *[]QueryInfo
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListItemsResponse) GetJSON200() *PagedResult {
+func (r ListQueriesResponse) GetJSON200() *[]QueryInfo {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r ListItemsResponse) GetBody() []byte {
+func (r ListQueriesResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListItemsResponse) Status() string {
+func (r ListQueriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1517,7 +2844,7 @@ func (r ListItemsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListItemsResponse) StatusCode() int {
+func (r ListQueriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1525,25 +2852,162 @@ func (r ListItemsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListItemsResponse) ContentType() string {
+func (r ListQueriesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateItemResponse struct {
+type QueryNamedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *UntypedDto
-	// JSON500 the response for an HTTP 500 `application/json` response
-	JSON500 *ErrorObject
-}
-
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateItemResponse) GetJSON201() *UntypedDto {
-	return r.JSON201
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *PagedResult
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorObject
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r QueryNamedResponse) GetJSON200() *PagedResult {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r QueryNamedResponse) GetJSON404() *ErrorObject {
+	return r.JSON404
+}
+
+// GetBody returns the raw response body bytes
+func (r QueryNamedResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryNamedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryNamedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r QueryNamedResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListEntitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *NameList
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListEntitiesResponse) GetJSON200() *NameList {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListEntitiesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEntitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEntitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListEntitiesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *PagedResult
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListItemsResponse) GetJSON200() *PagedResult {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListItemsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListItemsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *UntypedDto
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ErrorObject
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *ErrorObject
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateItemResponse) GetJSON201() *UntypedDto {
+	return r.JSON201
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r CreateItemResponse) GetJSON422() *ErrorObject {
+	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
@@ -1580,9 +3044,105 @@ func (r CreateItemResponse) ContentType() string {
 	return ""
 }
 
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ErrorObject
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r StreamEventsResponse) GetJSON400() *ErrorObject {
+	return r.JSON400
+}
+
+// GetBody returns the raw response body bytes
+func (r StreamEventsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r StreamEventsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetEntitySchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *EntitySchema
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorObject
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetEntitySchemaResponse) GetJSON200() *EntitySchema {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r GetEntitySchemaResponse) GetJSON404() *ErrorObject {
+	return r.JSON404
+}
+
+// GetBody returns the raw response body bytes
+func (r GetEntitySchemaResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEntitySchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEntitySchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetEntitySchemaResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type BulkUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ErrorObject
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r BulkUpdateResponse) GetJSON422() *ErrorObject {
+	return r.JSON422
 }
 
 // GetBody returns the raw response body bytes
@@ -1737,6 +3297,8 @@ type UpdateItemByIdResponse struct {
 	JSON202 *UntypedDto
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *ErrorObject
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ErrorObject
 }
 
 // GetJSON202 returns the response for an HTTP 202 `application/json` response
@@ -1749,6 +3311,11 @@ func (r UpdateItemByIdResponse) GetJSON404() *ErrorObject {
 	return r.JSON404
 }
 
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r UpdateItemByIdResponse) GetJSON422() *ErrorObject {
+	return r.JSON422
+}
+
 // GetBody returns the raw response body bytes
 func (r UpdateItemByIdResponse) GetBody() []byte {
 	return r.Body
@@ -1778,41 +3345,222 @@ func (r UpdateItemByIdResponse) ContentType() string {
 	return ""
 }
 
-// ListBackendsWithResponse List all configured backends
-//
-// Get list of all configured backends.
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /backends (the `ListBackends` operationId).
-func (c *ClientWithResponses) ListBackendsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackendsResponse, error) {
-	rsp, err := c.ListBackends(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+type GetBlobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r GetBlobResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBlobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseListBackendsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetVersionInfoWithResponse Get version info
-//
-// Get system version info.
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /version (the `GetVersionInfo` operationId).
-func (c *ClientWithResponses) GetVersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionInfoResponse, error) {
-	rsp, err := c.GetVersionInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBlobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetVersionInfoResponse(rsp)
+	return 0
 }
 
-// QueryNamedWithResponse Execute named query and return the result set
-//
-// Run previously-crafted query on the server and return results.
-// Saved queries should not contain any paging statement as those are provided
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetBlobResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutBlobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r PutBlobResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBlobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBlobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutBlobResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RestoreItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UntypedDto
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RestoreItemResponse) GetJSON200() *UntypedDto {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r RestoreItemResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RestoreItemResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// ListBackendsWithResponse List all configured backends
+//
+// Get list of all configured backends.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /backends (the `ListBackends` operationId).
+func (c *ClientWithResponses) ListBackendsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackendsResponse, error) {
+	rsp, err := c.ListBackends(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBackendsResponse(rsp)
+}
+
+// GetVersionInfoWithResponse Get version info
+//
+// Get system version info.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /version (the `GetVersionInfo` operationId).
+func (c *ClientWithResponses) GetVersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionInfoResponse, error) {
+	rsp, err := c.GetVersionInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVersionInfoResponse(rsp)
+}
+
+// FlushCacheWithResponse Flush response cache
+//
+// Drop all cached responses of named queries and entities within backend.
+// Caller must be granted `admin` operation on backend.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /{backend}/_cache (the `FlushCache` operationId).
+func (c *ClientWithResponses) FlushCacheWithResponse(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*FlushCacheResponse, error) {
+	rsp, err := c.FlushCache(ctx, backend, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFlushCacheResponse(rsp)
+}
+
+// ExecCommandWithBodyWithResponse Execute named command
+//
+// Run previously-crafted write command on the server.
+// All statements of command are executed in single transaction.
+// Request body holds values of named parameters declared by command.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+func (c *ClientWithResponses) ExecCommandWithBodyWithResponse(ctx context.Context, backend Backend, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecCommandResponse, error) {
+	rsp, err := c.ExecCommandWithBody(ctx, backend, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecCommandResponse(rsp)
+}
+
+// ExecCommandWithResponse Execute named command
+//
+// Run previously-crafted write command on the server.
+// All statements of command are executed in single transaction.
+// Request body holds values of named parameters declared by command.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /{backend}/_command/{name} (the `ExecCommand` operationId).
+func (c *ClientWithResponses) ExecCommandWithResponse(ctx context.Context, backend Backend, name string, body ExecCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecCommandResponse, error) {
+	rsp, err := c.ExecCommand(ctx, backend, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecCommandResponse(rsp)
+}
+
+// ListQueriesWithResponse List named queries
+//
+// List named queries configured within backend that caller is allowed to run, along with their parameters,
+// columns of result set and permissions of caller.
+// Columns are determined by running query with LIMIT 0, they are omitted when that is not possible.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /{backend}/_query (the `ListQueries` operationId).
+func (c *ClientWithResponses) ListQueriesWithResponse(ctx context.Context, backend Backend, reqEditors ...RequestEditorFn) (*ListQueriesResponse, error) {
+	rsp, err := c.ListQueries(ctx, backend, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListQueriesResponse(rsp)
+}
+
+// QueryNamedWithResponse Execute named query and return the result set
+//
+// Run previously-crafted query on the server and return results.
+// Saved queries should not contain any paging statement as those are provided
 // based on `page-offset` and `page-size` parameter.
+// Result set can be filtered and ordered same way as list of entity items,
+// columns are validated against result set of query.
+// Named parameters declared by query are passed as `p.<name>=<value>`, list parameters
+// either as repeated values or as comma-separated value.
+// All invalid parameters are reported at once with status code 400.
 //
 // Returns a wrapper object for the known response body format(s).
 //
@@ -1881,6 +3629,39 @@ func (c *ClientWithResponses) CreateItemWithResponse(ctx context.Context, backen
 	return ParseCreateItemResponse(rsp)
 }
 
+// StreamEventsWithResponse Stream changes of entity items
+//
+// Stream change events of entity items as Server-Sent Events, or as WebSocket messages when request
+// asks for upgrade. Only writes made through this bridge are streamed, and only items that principal
+// is allowed to read. Heartbeat is sent periodically. Events of delete carry item before change in `old`,
+// other events carry item after change in `new`.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /{backend}/{entity}/_events (the `StreamEvents` operationId).
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, backend Backend, entity Entity, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, backend, entity, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// GetEntitySchemaWithResponse Get schema of entity
+//
+// Get columns, keys and foreign keys of entity, as reported by information_schema of database.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /{backend}/{entity}/_schema (the `GetEntitySchema` operationId).
+func (c *ClientWithResponses) GetEntitySchemaWithResponse(ctx context.Context, backend Backend, entity Entity, reqEditors ...RequestEditorFn) (*GetEntitySchemaResponse, error) {
+	rsp, err := c.GetEntitySchema(ctx, backend, entity, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEntitySchemaResponse(rsp)
+}
+
 // BulkUpdateWithBodyWithResponse Perform bulk update
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
@@ -1909,11 +3690,13 @@ func (c *ClientWithResponses) BulkUpdateWithResponse(ctx context.Context, backen
 
 // DeleteItemByIdWithResponse Delete entity item by ID
 //
+// When entity has soft delete configured, item is only marked as deleted, unless `purge` is requested.
+//
 // Returns a wrapper object for the known response body format(s).
 //
 // Corresponds with DELETE /{backend}/{entity}/{id} (the `DeleteItemById` operationId).
-func (c *ClientWithResponses) DeleteItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*DeleteItemByIdResponse, error) {
-	rsp, err := c.DeleteItemById(ctx, backend, entity, id, reqEditors...)
+func (c *ClientWithResponses) DeleteItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *DeleteItemByIdParams, reqEditors ...RequestEditorFn) (*DeleteItemByIdResponse, error) {
+	rsp, err := c.DeleteItemById(ctx, backend, entity, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1925,8 +3708,8 @@ func (c *ClientWithResponses) DeleteItemByIdWithResponse(ctx context.Context, ba
 // Returns a wrapper object for the known response body format(s).
 //
 // Corresponds with GET /{backend}/{entity}/{id} (the `GetItemById` operationId).
-func (c *ClientWithResponses) GetItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*GetItemByIdResponse, error) {
-	rsp, err := c.GetItemById(ctx, backend, entity, id, reqEditors...)
+func (c *ClientWithResponses) GetItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *GetItemByIdParams, reqEditors ...RequestEditorFn) (*GetItemByIdResponse, error) {
+	rsp, err := c.GetItemById(ctx, backend, entity, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1938,8 +3721,8 @@ func (c *ClientWithResponses) GetItemByIdWithResponse(ctx context.Context, backe
 // Returns a wrapper object for the known response body format(s).
 //
 // Corresponds with HEAD /{backend}/{entity}/{id} (the `ExistsItemById` operationId).
-func (c *ClientWithResponses) ExistsItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*ExistsItemByIdResponse, error) {
-	rsp, err := c.ExistsItemById(ctx, backend, entity, id, reqEditors...)
+func (c *ClientWithResponses) ExistsItemByIdWithResponse(ctx context.Context, backend Backend, entity Entity, id string, params *ExistsItemByIdParams, reqEditors ...RequestEditorFn) (*ExistsItemByIdResponse, error) {
+	rsp, err := c.ExistsItemById(ctx, backend, entity, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1972,6 +3755,52 @@ func (c *ClientWithResponses) UpdateItemByIdWithResponse(ctx context.Context, ba
 	return ParseUpdateItemByIdResponse(rsp)
 }
 
+// GetBlobWithResponse Download content of binary column
+//
+// Stream raw content of binary column of entity item.
+// Content type is either configured for column, read from another column or sniffed from content.
+// Single and multiple byte ranges are supported via `Range` header.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /{backend}/{entity}/{id}/_blob/{column} (the `GetBlob` operationId).
+func (c *ClientWithResponses) GetBlobWithResponse(ctx context.Context, backend Backend, entity Entity, id string, column string, reqEditors ...RequestEditorFn) (*GetBlobResponse, error) {
+	rsp, err := c.GetBlob(ctx, backend, entity, id, column, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBlobResponse(rsp)
+}
+
+// PutBlobWithBodyWithResponse Upload content of binary column
+//
+// Replace content of binary column of entity item with raw request body.
+// Value of `Content-Type` header is stored in content type column, when one is configured.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /{backend}/{entity}/{id}/_blob/{column} (the `PutBlob` operationId).
+func (c *ClientWithResponses) PutBlobWithBodyWithResponse(ctx context.Context, backend Backend, entity Entity, id string, column string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBlobResponse, error) {
+	rsp, err := c.PutBlobWithBody(ctx, backend, entity, id, column, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBlobResponse(rsp)
+}
+
+// RestoreItemWithResponse Restore soft-deleted entity item by ID
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /{backend}/{entity}/{id}/_restore (the `RestoreItem` operationId).
+func (c *ClientWithResponses) RestoreItemWithResponse(ctx context.Context, backend Backend, entity Entity, id string, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error) {
+	rsp, err := c.RestoreItem(ctx, backend, entity, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreItemResponse(rsp)
+}
+
 // ParseListBackendsResponse parses an HTTP response from a ListBackendsWithResponse call
 func ParseListBackendsResponse(rsp *http.Response) (*ListBackendsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2024,81 +3853,91 @@ func ParseGetVersionInfoResponse(rsp *http.Response) (*GetVersionInfoResponse, e
 	return response, nil
 }
 
-// ParseQueryNamedResponse parses an HTTP response from a QueryNamedWithResponse call
-func ParseQueryNamedResponse(rsp *http.Response) (*QueryNamedResponse, error) {
+// ParseFlushCacheResponse parses an HTTP response from a FlushCacheWithResponse call
+func ParseFlushCacheResponse(rsp *http.Response) (*FlushCacheResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryNamedResponse{
+	response := &FlushCacheResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PagedResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	case rsp.StatusCode == 204:
+		break // No content-type
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListEntitiesResponse parses an HTTP response from a ListEntitiesWithResponse call
-func ParseListEntitiesResponse(rsp *http.Response) (*ListEntitiesResponse, error) {
+// ParseExecCommandResponse parses an HTTP response from a ExecCommandWithResponse call
+func ParseExecCommandResponse(rsp *http.Response) (*ExecCommandResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListEntitiesResponse{
+	response := &ExecCommandResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NameList
+		var dest CommandResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListItemsResponse parses an HTTP response from a ListItemsWithResponse call
-func ParseListItemsResponse(rsp *http.Response) (*ListItemsResponse, error) {
+// ParseListQueriesResponse parses an HTTP response from a ListQueriesWithResponse call
+func ParseListQueriesResponse(rsp *http.Response) (*ListQueriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListItemsResponse{
+	response := &ListQueriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PagedResult
+		var dest []QueryInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2109,61 +3948,231 @@ func ParseListItemsResponse(rsp *http.Response) (*ListItemsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateItemResponse parses an HTTP response from a CreateItemWithResponse call
-func ParseCreateItemResponse(rsp *http.Response) (*CreateItemResponse, error) {
+// ParseQueryNamedResponse parses an HTTP response from a QueryNamedWithResponse call
+func ParseQueryNamedResponse(rsp *http.Response) (*QueryNamedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateItemResponse{
+	response := &QueryNamedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UntypedDto
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PagedResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
-
-	case rsp.StatusCode == 405:
-		break // No content-type
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseBulkUpdateResponse parses an HTTP response from a BulkUpdateWithResponse call
-func ParseBulkUpdateResponse(rsp *http.Response) (*BulkUpdateResponse, error) {
+// ParseListEntitiesResponse parses an HTTP response from a ListEntitiesWithResponse call
+func ParseListEntitiesResponse(rsp *http.Response) (*ListEntitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BulkUpdateResponse{
+	response := &ListEntitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseDeleteItemByIdResponse parses an HTTP response from a DeleteItemByIdWithResponse call
-func ParseDeleteItemByIdResponse(rsp *http.Response) (*DeleteItemByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
+// ParseListItemsResponse parses an HTTP response from a ListItemsWithResponse call
+func ParseListItemsResponse(rsp *http.Response) (*ListItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PagedResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateItemResponse parses an HTTP response from a CreateItemWithResponse call
+func ParseCreateItemResponse(rsp *http.Response) (*CreateItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UntypedDto
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case rsp.StatusCode == 405:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEntitySchemaResponse parses an HTTP response from a GetEntitySchemaWithResponse call
+func ParseGetEntitySchemaResponse(rsp *http.Response) (*GetEntitySchemaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEntitySchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntitySchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkUpdateResponse parses an HTTP response from a BulkUpdateWithResponse call
+func ParseBulkUpdateResponse(rsp *http.Response) (*BulkUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.StatusCode == 500:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteItemByIdResponse parses an HTTP response from a DeleteItemByIdWithResponse call
+func ParseDeleteItemByIdResponse(rsp *http.Response) (*DeleteItemByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
@@ -2260,6 +4269,80 @@ func ParseUpdateItemByIdResponse(rsp *http.Response) (*UpdateItemByIdResponse, e
 	case rsp.StatusCode == 405:
 		break // No content-type
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBlobResponse parses an HTTP response from a GetBlobWithResponse call
+func ParseGetBlobResponse(rsp *http.Response) (*GetBlobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBlobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutBlobResponse parses an HTTP response from a PutBlobWithResponse call
+func ParsePutBlobResponse(rsp *http.Response) (*PutBlobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBlobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRestoreItemResponse parses an HTTP response from a RestoreItemWithResponse call
+func ParseRestoreItemResponse(rsp *http.Response) (*RestoreItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UntypedDto
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	case rsp.StatusCode == 405:
+		break // No content-type
+
 	}
 
 	return response, nil
@@ -2273,6 +4356,15 @@ type ServerInterface interface {
 	// GetVersionInfo Get version info
 	// (GET /version)
 	GetVersionInfo(w http.ResponseWriter, r *http.Request)
+	// FlushCache Flush response cache
+	// (DELETE /{backend}/_cache)
+	FlushCache(w http.ResponseWriter, r *http.Request, backend Backend)
+	// ExecCommand Execute named command
+	// (POST /{backend}/_command/{name})
+	ExecCommand(w http.ResponseWriter, r *http.Request, backend Backend, name string)
+	// ListQueries List named queries
+	// (GET /{backend}/_query)
+	ListQueries(w http.ResponseWriter, r *http.Request, backend Backend)
 	// QueryNamed Execute named query and return the result set
 	// (GET /{backend}/_query/{name})
 	QueryNamed(w http.ResponseWriter, r *http.Request, backend Backend, name string, params QueryNamedParams)
@@ -2285,21 +4377,36 @@ type ServerInterface interface {
 	// CreateItem Create new entity item
 	// (POST /{backend}/{entity})
 	CreateItem(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity)
+	// StreamEvents Stream changes of entity items
+	// (GET /{backend}/{entity}/_events)
+	StreamEvents(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, params StreamEventsParams)
+	// GetEntitySchema Get schema of entity
+	// (GET /{backend}/{entity}/_schema)
+	GetEntitySchema(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity)
 	// BulkUpdate Perform bulk update
 	// (POST /{backend}/{entity}/bulk)
 	BulkUpdate(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity)
 	// DeleteItemById Delete entity item by ID
 	// (DELETE /{backend}/{entity}/{id})
-	DeleteItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string)
+	DeleteItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string, params DeleteItemByIdParams)
 	// GetItemById Get entity item by ID
 	// (GET /{backend}/{entity}/{id})
-	GetItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string)
+	GetItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string, params GetItemByIdParams)
 	// ExistsItemById Check for existence of entity item by ID
 	// (HEAD /{backend}/{entity}/{id})
-	ExistsItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string)
+	ExistsItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string, params ExistsItemByIdParams)
 	// UpdateItemById Update entity item in-place by ID
 	// (PUT /{backend}/{entity}/{id})
 	UpdateItemById(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string)
+	// GetBlob Download content of binary column
+	// (GET /{backend}/{entity}/{id}/_blob/{column})
+	GetBlob(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string, column string)
+	// PutBlob Upload content of binary column
+	// (PUT /{backend}/{entity}/{id}/_blob/{column})
+	PutBlob(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string, column string)
+	// RestoreItem Restore soft-deleted entity item by ID
+	// (POST /{backend}/{entity}/{id}/_restore)
+	RestoreItem(w http.ResponseWriter, r *http.Request, backend Backend, entity Entity, id string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -2309,13 +4416,424 @@ type ServerInterfaceWrapper struct {
 	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListBackends operation middleware
+func (siw *ServerInterfaceWrapper) ListBackends(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBackends(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVersionInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetVersionInfo(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersionInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FlushCache operation middleware
+func (siw *ServerInterfaceWrapper) FlushCache(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FlushCache(w, r, backend)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecCommand operation middleware
+func (siw *ServerInterfaceWrapper) ExecCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecCommand(w, r, backend, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQueries operation middleware
+func (siw *ServerInterfaceWrapper) ListQueries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQueries(w, r, backend)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryNamed operation middleware
+func (siw *ServerInterfaceWrapper) QueryNamed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryNamedParams
+
+	// ------------- Optional query parameter "page-offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-offset", r.URL.Query(), &params.PageOffset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-offset", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order[]" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order[]", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order[]"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order[]", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "arg" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "arg", r.URL.Query(), &params.Arg, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "arg"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "arg", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryNamed(w, r, backend, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEntities operation middleware
+func (siw *ServerInterfaceWrapper) ListEntities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEntities(w, r, backend)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity" -------------
+	var entity Entity
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity", mux.Vars(r)["entity"], &entity, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Optional query parameter "page-offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-offset", r.URL.Query(), &params.PageOffset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-offset", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order[]" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order[]", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order[]"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order[]", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", r.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "search"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "search-mode" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search-mode", r.URL.Query(), &params.SearchMode, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "search-mode"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search-mode", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "search-score" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search-score", r.URL.Query(), &params.SearchScore, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "search-score"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search-score", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "include_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "only_deleted" -------------
 
-// ListBackends operation middleware
-func (siw *ServerInterfaceWrapper) ListBackends(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "only_deleted", r.URL.Query(), &params.OnlyDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "only_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "only_deleted", Err: err})
+		}
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBackends(w, r)
+		siw.Handler.ListItems(w, r, backend, entity, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2325,11 +4843,32 @@ func (siw *ServerInterfaceWrapper) ListBackends(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetVersionInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetVersionInfo(w http.ResponseWriter, r *http.Request) {
+// CreateItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity" -------------
+	var entity Entity
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity", mux.Vars(r)["entity"], &entity, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVersionInfo(w, r)
+		siw.Handler.CreateItem(w, r, backend, entity)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2339,8 +4878,8 @@ func (siw *ServerInterfaceWrapper) GetVersionInfo(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// QueryNamed operation middleware
-func (siw *ServerInterfaceWrapper) QueryNamed(w http.ResponseWriter, r *http.Request) {
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2354,59 +4893,80 @@ func (siw *ServerInterfaceWrapper) QueryNamed(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "entity" -------------
+	var entity Entity
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entity", mux.Vars(r)["entity"], &entity, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params QueryNamedParams
+	var params StreamEventsParams
 
-	// ------------- Optional query parameter "page-offset" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-offset", r.URL.Query(), &params.PageOffset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-offset"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-offset", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "page-size" -------------
+	// ------------- Optional query parameter "q" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-size"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "arg" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "arg", r.URL.Query(), &params.Arg, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "arg"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "arg", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
 		}
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryNamed(w, r, backend, name, params)
+		siw.Handler.StreamEvents(w, r, backend, entity, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2416,8 +4976,8 @@ func (siw *ServerInterfaceWrapper) QueryNamed(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListEntities operation middleware
-func (siw *ServerInterfaceWrapper) ListEntities(w http.ResponseWriter, r *http.Request) {
+// GetEntitySchema operation middleware
+func (siw *ServerInterfaceWrapper) GetEntitySchema(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2431,8 +4991,17 @@ func (siw *ServerInterfaceWrapper) ListEntities(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "entity" -------------
+	var entity Entity
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity", mux.Vars(r)["entity"], &entity, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEntities(w, r, backend)
+		siw.Handler.GetEntitySchema(w, r, backend, entity)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2442,8 +5011,8 @@ func (siw *ServerInterfaceWrapper) ListEntities(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListItems operation middleware
-func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+// BulkUpdate operation middleware
+func (siw *ServerInterfaceWrapper) BulkUpdate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2466,63 +5035,68 @@ func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListItemsParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkUpdate(w, r, backend, entity)
+	}))
 
-	// ------------- Optional query parameter "page-offset" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-offset", r.URL.Query(), &params.PageOffset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteItemById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "backend" -------------
+	var backend Backend
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backend", mux.Vars(r)["backend"], &backend, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-offset"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-offset", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backend", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page-size" -------------
+	// ------------- Path parameter "entity" -------------
+	var entity Entity
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page-size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "entity", mux.Vars(r)["entity"], &entity, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page-size"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order[]" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "order[]", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order[]"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order[]", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteItemByIdParams
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	// ------------- Optional query parameter "purge" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "purge", r.URL.Query(), &params.Purge, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "purge"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purge", Err: err})
 		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListItems(w, r, backend, entity, params)
+		siw.Handler.DeleteItemById(w, r, backend, entity, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2532,8 +5106,8 @@ func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateItem operation middleware
-func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Request) {
+// GetItemById operation middleware
+func (siw *ServerInterfaceWrapper) GetItemById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2556,8 +5130,46 @@ func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemByIdParams
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "include_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "only_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "only_deleted", r.URL.Query(), &params.OnlyDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "only_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "only_deleted", Err: err})
+		}
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateItem(w, r, backend, entity)
+		siw.Handler.GetItemById(w, r, backend, entity, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2567,8 +5179,8 @@ func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// BulkUpdate operation middleware
-func (siw *ServerInterfaceWrapper) BulkUpdate(w http.ResponseWriter, r *http.Request) {
+// ExistsItemById operation middleware
+func (siw *ServerInterfaceWrapper) ExistsItemById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2591,8 +5203,46 @@ func (siw *ServerInterfaceWrapper) BulkUpdate(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExistsItemByIdParams
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "include_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "only_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "only_deleted", r.URL.Query(), &params.OnlyDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "only_deleted"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "only_deleted", Err: err})
+		}
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BulkUpdate(w, r, backend, entity)
+		siw.Handler.ExistsItemById(w, r, backend, entity, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2602,8 +5252,8 @@ func (siw *ServerInterfaceWrapper) BulkUpdate(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteItemById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteItemById(w http.ResponseWriter, r *http.Request) {
+// UpdateItemById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateItemById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2636,7 +5286,7 @@ func (siw *ServerInterfaceWrapper) DeleteItemById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteItemById(w, r, backend, entity, id)
+		siw.Handler.UpdateItemById(w, r, backend, entity, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2646,8 +5296,8 @@ func (siw *ServerInterfaceWrapper) DeleteItemById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetItemById operation middleware
-func (siw *ServerInterfaceWrapper) GetItemById(w http.ResponseWriter, r *http.Request) {
+// GetBlob operation middleware
+func (siw *ServerInterfaceWrapper) GetBlob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2679,8 +5329,17 @@ func (siw *ServerInterfaceWrapper) GetItemById(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Path parameter "column" -------------
+	var column string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "column", mux.Vars(r)["column"], &column, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "column", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetItemById(w, r, backend, entity, id)
+		siw.Handler.GetBlob(w, r, backend, entity, id, column)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2690,8 +5349,8 @@ func (siw *ServerInterfaceWrapper) GetItemById(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ExistsItemById operation middleware
-func (siw *ServerInterfaceWrapper) ExistsItemById(w http.ResponseWriter, r *http.Request) {
+// PutBlob operation middleware
+func (siw *ServerInterfaceWrapper) PutBlob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2723,8 +5382,17 @@ func (siw *ServerInterfaceWrapper) ExistsItemById(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "column" -------------
+	var column string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "column", mux.Vars(r)["column"], &column, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "column", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExistsItemById(w, r, backend, entity, id)
+		siw.Handler.PutBlob(w, r, backend, entity, id, column)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2734,8 +5402,8 @@ func (siw *ServerInterfaceWrapper) ExistsItemById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateItemById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateItemById(w http.ResponseWriter, r *http.Request) {
+// RestoreItem operation middleware
+func (siw *ServerInterfaceWrapper) RestoreItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -2768,7 +5436,7 @@ func (siw *ServerInterfaceWrapper) UpdateItemById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateItemById(w, r, backend, entity, id)
+		siw.Handler.RestoreItem(w, r, backend, entity, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2895,12 +5563,22 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/{backend}/entities", wrapper.ListEntities).Methods(http.MethodGet)
 
+	r.HandleFunc(options.BaseURL+"/{backend}/_query", wrapper.ListQueries).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/{backend}/_cache", wrapper.FlushCache).Methods(http.MethodDelete)
+
 	r.HandleFunc(options.BaseURL+"/{backend}/_query/{name}", wrapper.QueryNamed).Methods(http.MethodGet)
 
+	r.HandleFunc(options.BaseURL+"/{backend}/_command/{name}", wrapper.ExecCommand).Methods(http.MethodPost)
+
 	r.HandleFunc(options.BaseURL+"/{backend}/{entity}", wrapper.ListItems).Methods(http.MethodGet)
 
 	r.HandleFunc(options.BaseURL+"/{backend}/{entity}", wrapper.CreateItem).Methods(http.MethodPost)
 
+	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/_schema", wrapper.GetEntitySchema).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/_events", wrapper.StreamEvents).Methods(http.MethodGet)
+
 	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/bulk", wrapper.BulkUpdate).Methods(http.MethodPost)
 
 	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/{id}", wrapper.DeleteItemById).Methods(http.MethodDelete)
@@ -2911,6 +5589,12 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/{id}", wrapper.UpdateItemById).Methods(http.MethodPut)
 
+	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/{id}/_restore", wrapper.RestoreItem).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/{id}/_blob/{column}", wrapper.GetBlob).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/{backend}/{entity}/{id}/_blob/{column}", wrapper.PutBlob).Methods(http.MethodPut)
+
 	r.HandleFunc(options.BaseURL+"/version", wrapper.GetVersionInfo).Methods(http.MethodGet)
 
 	return r
@@ -2921,50 +5605,103 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"1Fpdd9u40f4rOHjfi2xLfTgfvdA5uXAkdVet63j9sTeRjwURIxIxCXABUIrWR/+9ZwBSJEXKdtI43eYm",
-	"JgnMDJ6ZeTAD6IGGKs2UBGkNHT3QjGmWggXtnpYsvAfJ8U8OJtQis0JJOqLnLAWiVqQYQKwiK7BhTEBa",
-	"YQUYstIqpQEVODpjNqYBlSwFOtoLDaiG33OhgdOR1TkE1IQxpAy1pezLGcjIxnT0tzcBTYUsH08CFGdB",
-	"o+BP8/nmrnf7VxpQu81QuLFayIjudgF1pmyP215877Rx/+1lTcxYBD21WhmwbTsvWIR2uo+Fkb/noLeV",
-	"lfXpDdOEFGme0tFwr1RICxHoSqsRf8ARne7TIxqL7w0ovL6T4TCotJ90aN+V81x8fciT+5uMMwv/UrzD",
-	"HHyLrsrdmNFckr+Qxez8anp5vSA98nH5GUJrCNNAhDSgLfA+GSu5SgS+34gkISHLDRAmCWitdN/LuJxe",
-	"nJ2Op4dCNKRqDZxkWiiNQR1qYFbIiIgVsTFsCXwRxhqU0vh3miQ4KWV6S+5ha0iaG0uWQDINBqQlQhIM",
-	"JTC2sODmYnJ63TLAL5T3v1Zg49+5kj1nJ45TdYwiqTTwwoLJ9GzatoBDAt9gAcV8Q6d/ot4/NKAFyDSg",
-	"frE0oF4nvW0lQ1CLhUsv1dGRVhloJBQXZ0WQ/L+GFR3R/xtU3DUoompwEFK7gBYItKPrNLQ5SwhnlhUw",
-	"ocszrUIwBhPAQmqe0ncjcSV8YhXqSoWc+VlV8DOt2dYlXkUmn/xaKuMqRPwbFDbFgPW+aRv/M0jQIqwZ",
-	"Hiq5xgjFWYSDZSLBVTQhDDvzbIx5piHB2ENRXoaxzAJtJXFAv/Qi1aveoq0IIsplnAsUypKLml7PnQfo",
-	"816sQo8+M0aFwmnfCBt7/Q5PMIZFHRb/kqcM449xtkyAFOMIW6rcdptfo92GHwoNt7vA7QxnwnSgjW+R",
-	"iJAGTUBMHsaEmXL7M0Tp/c5XD5xWkDdDInB8yy/B5MmRDYCTpFDthR76s8T9G2L10JZj+9BH9x5NCHOt",
-	"MfNxF3AbPHIiWUIkpMQF7mXKPF2Cc6BVliV3ocplh+Rr/Ej84P0SScpsGCPnlupWIrEu7jr2slbS1Nb4",
-	"VeF4I43VeWhzDRxTCckzIFyEbrreon3ejz2rekxuyZolOZg+7TBC3ysDkbiLVC+2NuuFKk2VNHcaEmAG",
-	"zB1XG5koxu/Ww/6w//aOZeLuamsspL+BNkLJmVw9soAVS0xrBVMZsszkmMSGeI1k7aURIVdKp8yNPAyh",
-	"ZS4S3rMi7Uiza5EC2cQgCcuyRIROAtlg6OcisbSDx7243IBui7sxoMkmVn52XWaXJA1rYdzEQzm/ja/I",
-	"/mvHzGLZHZRfW0U5qIshDjyKr0ThkYMcBY3IkvHlzYQgql42i5iQxjp2WzIDFVPkBkP7cnp1TU4vZhg9",
-	"iQhBGgd+UWWdZiyMgbzuD2lAc53QEcUwMqPBYLPZ9Jn73Fc6GhRzzeBsNp6eX017r/vDfmzTxOWesAmK",
-	"m5RGWLVXTJZa8AjpcY8VXZ/0h/2ho4IMJMsEHdE3/WH/DXUFbeyiZVCuBB8i6NyZ7J60GBZgSq5E5PJq",
-	"P9ep8FjNeMGvH6qPGkymcF0o/fVw6Hcul5MuKSovDj4b7+eqGn2MAfcM71zazfHHbHa1a55iQVSOPr48",
-	"yyKD20vZ7Nzi7EEtLo9CZxwNNDK3BdfPYOs88YKAfScm64D7t/oCm9giDAfrL/F0jx7MhwLb3eDOdSmD",
-	"B0yf3VFwL3OJtetaqNwk216o2QoLDjeXKOn2MgN6DZowyYkGm2ssMXBzxor/iq2L4djfmljlCSdSWbdb",
-	"MCEJbgoZizC/Xe2R4ubFDLGxwg5EY+ms1oIDn0vMR45aF7UmbuEUL/ZN1oLs23FfYjej4Fe0HCOauwSt",
-	"GvdP3T6thpRJTHfBk0PrTeZzh7sOEQd3d94ecqz0hIe9zKFyK+joyN1/j/XjLRJvcf9+KyVMRzl6x5CM",
-	"GeNr3rLT7Wp7mY4aDe9zC7zd7QumZr127MgvFx0EvkCYY5hjZLla28c1oO/p2+Hb72ZOvVvpMKfpe2Fc",
-	"5mgIVSTFH2hNgwGm3mxXb5cpWktKjBmfmMSFZZMO9pX4c3aoe6k2sjq2KoIyEmuQpDqpau9W06rc/8bU",
-	"u/0T7HPPWf2Rfa97agVZydjFUdohZz/498fp2inyg4rGwJdOJYeSUAsLWrBO98zKbumrfPMdua5EWWkO",
-	"moiiuxBKGiIkcTWjWpHFPWzfc6HBfVr05/KfsCUaijMWUwKwEpBw8ipUSZ7Kn3wyl7MwmZR0ybU4vRov",
-	"sBldTKZX40Uwx7KfwBeWZgmQBWbT+/0Qwd+7Uf25nOxlhUySJRCVCmuBB2ira3ZDrB+9eGGwX89Td5B0",
-	"hDDdqj/dfhtptrD8u2sAUfE/rj6e90CGCgPAv55+QbCwVujP5di3jMmWmDzLlHbnGdsM/NnWwggEYhGQ",
-	"hVTlbvs5l3vwpx4pM5rLueyRxcOc+ilzOiIPZO6Wh3/PqeBzGpA5VZl/fu8f1yyZUzIiJ7vdAoUQgmbj",
-	"prVmCdYDVpGrX88c+ieLSo1UttTxXI3zfDh8Awdqh8PdU5rPP16TV4L76SfD4U81M0ownPZK1en5xOsx",
-	"+dLp+fQIMiyCpw198263C8gjUgxLmN4+LejdEJd8+9SiX6FVbvqbdz+R0/MJeeU1EP/WyUEkjgT0/gzi",
-	"eMHxX9zrzxpnRB2EXefRGjeHOufu5OvFqseC/RGcTPmTtSZZjzUwC0jXRXUHxn5QfPvdoKsferWRG3sV",
-	"1X2Qw2h/9A/+HKLh1ZMfZZozgJPJ9Udfq73rOLh1Y8pyiiWJ2gDv4/B33zH6nijtbqQ7g91jVt6sNeOw",
-	"MFXCpo50Oxi7y4TBMk/uDy8of2ykVjcLLxSp7RuQ3W6362aVpgdwZu30CWW7Wxzcu0MwZpUnybYWFc3Z",
-	"M2lBY1vkz803sUigvAbBimvJbBgfeLM890K3FLdWz3blg+A7bwUa2QZ64t4jJXzYznj7dONtxxIwaTfM",
-	"lDd4/aMZ44W3M6axumJQnRGWWzKbdFFnUby2DmiO2z/8QQQyraXZj232vDuEjYtWYjYhXIGH3N1MHnXP",
-	"JTD+hHOwe3uWZ2JgvO2aqbvB/froKm5+a0h+5Zr7/8mixzGE98RX8+5mN4TDPesoDi9Oma2CfTY5MK77",
-	"TEe89I9AkMvzjvT0LNuIgT9P4VGwaZv4X/8g0zw6vOG//xX+8LY/kUzFoDruQvayhIVwLIucAHc47JPI",
-	"38o8ZFpZFapkNxoMHmJl7G70gG3nbsAyMVif0ICumRZYHzknxqq8XV4xd+dLExWyxL0+BOEXZawsTs1O",
-	"L2bF2bRjEVTRFPP69XB40hJxobQlSuKWHsY1IYhP4mhEyMhLLBbSlBpbm7WEXseuOnDDHSOxsKwUbAz+",
-	"Vmvncq/AsFWtFD8aK39wsM9N0/6RWJtbil3tsclHeal5S1eb4bzcwWPVtSlLOif624vb3b8DAAD//w==",
+	"7H1tcxs3kv9XwX/+eyX7bkhRtpMX2tILWVJi3XplryQnV2W6RHCmSSIeAmMAI5nr4ne/6gbmiYOhKMdW",
+	"dlOXFxY5g4dGox9+3WgwX6JELXMlQVoTHX6Jcq75Eixo+jblyUeQKX5MwSRa5FYoGR1GF3wJTM2Yb8Cs",
+	"YjOwyYKBtMIKMGym1TKKI4Gtc24XURxJvoTosBo0jjR8KoSGNDq0uoA4MskClhxnW/LPr0HO7SI6/PF5",
+	"HC2FLL8exDicBY0Dvx+P724GH/4riiO7ynFwY7WQ82i9jiMiZdVPu38fpLF6931JnInMgu6S+BM9Z8Kw",
+	"/756czEAmagUUuYen33ONRgjlByO5VWR50pbSBmObhjXwCZGLPMMJjGbSGXxz2+FTHBo/Czo34L+nYK9",
+	"A5ATxmXKJr8ZJSfDsXyTg+ZWaYNcKgdrNHGTHOEA42I0ep7QvzTf/6ufQv2p0bT+RA9fn//tDP9evLlm",
+	"5edz92EsJ5dnP5/9z9vyff0tUdJyIQ1+NpZra34VduFJBJm6r0P2mhvL7EIDsCVH6cyEBc0zZuGzjcfy",
+	"TmRpwnVqmJDslmcF0NLAJDyHdMhKRhAfhJzghoCwC9Bscn4xYU9SmPEis0+Z0o7G8wtk4Nlnjjwzh2M5",
+	"lgM2+TKOHBfH0SH7wsYkZfh5HIl0HMVsHKncfT9yX295No7YITtYryc4CGM096dC3PIMpEWFu/rHazYR",
+	"6dHBpJ5GKlvOseuMbos2ph2N1vfNjMt9IlLX/WA0etogo5Q3mr2e6vji1M1jiinN834LZ/gc7if0+Q/r",
+	"dcy2jGJ4xvXq/oF+GOGSP9y36CdIFXV//sNTdnxxyp64GZh7SuM0OSFkl6YUctumyIlOg6JD9n4cvbp0",
+	"T86vx9G9lOGgzI3Dnuy9utyL2d759V5rV4wKUMOt1cZNhDbQPfzLkKepBmOGibAb7Ks1rkXwOHqp+Ti6",
+	"j06abuC3gP7da0+2R4aA7b3U/D/2JmNZGuhPBehVbaG96Wxa5K59FTLJihQGKWRgIeDHzl0DZtTMlq2Y",
+	"sLA0MbtbgPROgi24oTbMtWGJkjMxLzSkwx76/NQ35dQBQqdKZcAlUapktuon82qh7hg2+Q6E4rA7U6nT",
+	"kLt6LYxFG0mvmZDG6oL0nwzrTOklWdCPsDpKhQbnioZj+TdYMQ3ozUBaUy5hJiBL2ZNEZcVSPiWjXvVC",
+	"oVKS3Pfk+OpkQob39OzqBP3FTGkGzvSyCa7uqGoi0iNqNRzL02qshEs2BaaWwlpIY6TVFMmCJdyAH14Y",
+	"xo0plpAOeyWRVv3+Q4t3tDMBmaxAANear4inOZ/DQM1mBmyXs2/5HJh/GZ692b0FT4QUy2IZHY6qOYW0",
+	"MAddz2rEP6FnTnq1ZUb/vgWH3HwHo1Fcz34QnP1TP+SR7BLthFlJyz/HjONTya24BTQhE6f2E1bh1JhN",
+	"lcU9k3sWd7MwkDJumZIJDMfyRKE0coHyhb79NyUkpGy6YpO/TtiT44tTJ2GTeMKevLl8GpNRnwqZGmbF",
+	"fIE02QWX7M0ltcu5BmkXYMCQ/My1KnJmF7CkyZY518IoyVSFoRxYOqrR0dHcHk3YkxIlPaVH0Hh05J5l",
+	"jWaJf9RolvhmQrpRVWGPCDUdZeKjg1xHUtn6i6g/apjD56pJ41uJrNw3Z+nvhF247yDT8hvO49HjkQde",
+	"R8LIIsuOJkN2BRkkVmli0YLfAkPXwrCvkIRpmVNvxvMcZIrah9R599DyB6izvyA2M7gRlnkSmQYD+hZS",
+	"liy45okFbdiyMCQEnwplIa2R2KG3B0cv1fSvzmHjLhyMRqM4hZxruwRpkZVPzq/jV5foNXsAAI7Djtje",
+	"SzXdI1lp+38c8ikKSz2s88jn1+iRX13uPcUFveXaAAOtSwnR4GE88ohNXoxGk5jlygiyVGrGcq2mGSzR",
+	"IgnJJim3fFi+n/Qbp09bPeS6fEm26mWRfXyXp9zC31UaMAz4FEkpqM3hWLL/RBx8dXZ5PWED9mb6GyRe",
+	"zYQ0gMsZshMlZ5nA53ciy1jCCwOMS7f2oRvj8uzt6+OTs81BNCwVbnCuhdK4A4kGboWcMzFDlVsx+CyM",
+	"NUOCG43/jrMMOy1xXz7CqpYL72oYic+nAoz1FLx7e3p83SHALTQdPnTA1n8XSg6ITmynmjyaS6Uh9RSc",
+	"nr0+61Lg/fJDKYjiCCSa3/eR258ojjyTozhyi43iyM0ZfYi7bqqWhUs3KiUHNJo1K4DEZemF5C8aZtFh",
+	"9P/360zCvpeq/Q2RQgjhVteVruPEFjxjKNieTbjluVYJGBPFtUvdNt87iStJT63CuZZCnrteBwHHW4f2",
+	"791aauJqjrgnONgJGaxzOVNd2t071A0NpsgsM2CZ0nWKoc05p5sBcID2k08zCMGvkqTNuU+55VNugBIA",
+	"DIeOHYzhhv1yfHny6vgSSTm/uA6mIJpcILp8ozALlksu00taY5eSN4VNlMut4EgpS1z7zvr5bAaJhfRG",
+	"q7uAJFwryzMmi+UUKPTGVqzsg66bZxkzlltA82qiLsSII1XYh8lKY7wmfts2wFXZxTNkfY+QtZfdmjLE",
+	"7TMSnqvKeG+EBPS8lcdqM9k52Aeshv46Se4uJY5mSoOYyxu0PjsP+pPr9DdYhYYU6Y2jslejHJoz7Py0",
+	"XqkLe1AOjt+eRwHb1ate3oDiEvpmNM7XVoa2aXjuwfJxVEjxqYCHcegd9QkyKKydNdPiao/bS2sTsrFz",
+	"QUlDX+zcTpcvP4MELZKGTU6UvEXni71YCpaLzATELwQhThBCaMg4ZSyVH4MUoavGcfR5MFeD+inSiv6B",
+	"bEiaEvbh2dvGvC5Ju+FY0sFCJc6xcGNUIngFtGh+chVgDJ8HKH5VLLlkGniKhpn5doxPVWHD5PcY13KG",
+	"D+s4aqhFNxBy71D4Hqjd98pnr2JomN181YjYsS/Pfgkz0CATSOtF7OR/aqluDN8mMiTFmNfHTER/fgLH",
+	"N7V39AcRpvLUyNuH6DtGyr3ekF6yzE/tBt3cxVKYvwLbbNLSl0F4Q8+RhKTQGqRlGL/T8QxiaDaFuZAS",
+	"F1iN6TwvTYKe+CZRhbT3u2lnlinRjhi9nK5K1AXyAJ09/AdGL2GMdVp/qxFGGe30Kkevja+B2q7YsgEA",
+	"A/xvTfTlIU4JMxm7+wvi0Fvs00dJDnop6Hxox8Ea7Xs00tPYHvtD3/7V1AU2Mcm45u1NrHI5Xf1w5ytB",
+	"vrkA5yHGClvehEH0NWJnNXPqWupqZ8Al/9yYqFaTpZDB51v23B8PBm1xyf7dY4A3LiUqpI1ZColY8ixm",
+	"bsgYHR9glkxlaOhwhQ8KBBpv+ne8LXIbhrB+SWaIZxkaDLlVheEzJIWFnjx0k9ayZYi4FqbdErX1OFle",
+	"WHUjZKIJpYc3xNmaHrH6qcgyF5elMBOSAEvtf265xtzVkx9f0AGikJYV0oi5pER8RzAayrCpVPSiPME0",
+	"dTJpumKpDw975Pkm84fWnWQP5nN5xtx72rgyz4bEToVEhFwhUUzyc+vM+48vgiHZV0a9uYZEGG9WNySr",
+	"fEW2pFgSTq1I6lKAKO4Gzzu6Q1GGkQn0X5Ic93TFplqkc4gdXw0zRZ5nAlImJMv5KlM8bSVyojhAvUl4",
+	"BqHojWewI9WPGva3BbqxN/GmNgT1bSMi3pYiMELOM6jj+N+dNrjoTxhUk/SIBRJ7YyCUlrqscALqlS20",
+	"3BiygSfxKUqCy1qlhYYmtvgGIO8B6YXQ9tSxZmeZ7tUjBh3boX+Y+oo1D4r/3vnzSDwEpdMD2rRU0CEg",
+	"GjEURqJqYNWAy5VX+GEUIEJ/VAbm4mauBgtr8wHKqpLmRkMG3IC5SdWdRNNwczsajoYvbngubq5WxsLy",
+	"F9Boq0pgFF7AjGems4IzmfDcFBg1G+ZmZLduNCakM73YcnO/poXI0oEVyxDiEUtwJ8cczVpCI7A7FONC",
+	"ZDbkLtxwhQkdAb8zoNndQrnezTFDI2m47THpv5xcseptoKdfdiB93FhF2Shk+DZ2dE1VAiGo+hY0cpad",
+	"XL479cd5ODafcyGNrfxqHUUWaNHY5dnVNSamUHoykYA0UKd8o+OcJwtgz4ajKI4KnUWHEYqROdzfv7u7",
+	"G3J6PVR6vu/7mv3X5ydnF1dng2fD0XBhlxnpmLAZtJyAqib2bitq8Cq6PRiOhiPsqXKQPBfRYfR8OBo+",
+	"J2xvFyQt++VK8MscgqkgWwW0mIOtiwsqLkRxVPHqPPWx98v6pQaTK1wXjv5sNHJmhXQSPzbkZh/LVfBZ",
+	"fXy1zXBW0T9taTj+76MZe5hiiQm0snX/8iyfG7RY/hFW5sTRfkMue1lnyAy0NLfDrp/BNu3Ed2TYN7Jk",
+	"AXb/0lxgm7fIho31l/ykr46ZXzxv1/s3CSqEY2cGNoSDtMrdbmHLlFX8aicJBBg6pa7qU/1ZtJ8JD+9d",
+	"XFKeq801lxZSNuHpEkv/agugmr06G/hTVpjFCVEdt6pp34e3o25S6l+0/tDZ9heB6AXnIIM9wykhRfV+",
+	"MXr+zQSkmRUO7LLnlzBMKtIXdedyukSO246N3SfeVDvkm/RpVFMIHCDc/4L7uUa6cxXK810WkuXoPVRh",
+	"stUg0XxGmV4tqBKKBsHtw7wXFQ/gAfRx6zCJAh3fkmpBXXRJoN8jVqu5NJzQw3As/fEom6p0xRYqS00Z",
+	"L3TzGwaD84xrhyD9NCEhOvsMyUmNgr9OiuK+wudyfV4FkBulqSs9dqAkmv5sK4je9LUfXGMw9qVKV99M",
+	"LJsIee1g5HeykO3jzpAKeEaWQuI0cPRYGniFkVRDuFzhxS3PhKfkxWNRsilZ3ihoSNRcin9CumEIzhzD",
+	"OvHevZbAJYv6nCx57rbNb/jwtsX3xUSVEWsYMF1ILDxTck59UEGEbjA6HssklEh2BWKhbBfVhrkerqrD",
+	"YisfSeqC8u8uEeZmfH3+9/NrNopdlQvXVZGiQ+xEuudxrowR0wxCZgT58Q/HiG/pjB4m4LtntcMJ7X44",
+	"19rpEIhrN9hVvBpuZg67exm3fS3vQgLhkgZeTLBW6YrfNgTULFSRpbSVZWUbhqA5n6NM1NkRbphdKONu",
+	"KORa3YoU0rFE9E8+bdIoBPUFeVWhZqNckjxWJbC+AtYd0UBK3aicFVJmUKfv+ApnLkF/8wC+oQVIEpkd",
+	"OlgtI6RmKczMsWc4lhfbXKLjIa2QG3/0P8mHrt6RStZdgaR7QH62umtCNNbjjqW/qeHyoUCklZ6ZnpLZ",
+	"GRjAPtVLDwi8Gd20rlVi1ReYOm3FTSpwvBTYi9EopIkk37T03+XP72naEIGdm6N87NKYxGKXhk6Ydmn5",
+	"aQtGqY3hd0QondmPq5xMVXvJM8b1vHDg0EulVZ4+MsSpAmeKvSR3UF9PaSbX86+rGf/wHTFP80g7YHpJ",
+	"jmtQTLX53PIqM/oH4Q63Gw9DHa5Pw0KjlNU2K9rwDVWBwC7JkY9S3clOrDkXt1Dhj6CzPqurEP4gb/0t",
+	"Uiy7rL4n5RLuWrOs9OA+Qb3pwL+45+vtCLFVRuaydqVDZYkWFrTgwe05L4s4HrQ3fwKrjOeYA7w0yQzg",
+	"oWW5MSUAaKDsWVX2GqOHnRLKxeYeZQzHria3un3j4QYCYcjglqNb9ZtioEjVwE3CJjcmURom9XnLxN+8",
+	"OXJvjpDmLaXwjvTNSzKNS7v3uoeyAH62wY6Y+bNZg65hia2CHBlupWzgi5Br8soabsltoXkWxdXJ4ocd",
+	"qP2VQgV0gQ3OIoi7JWNp3WWC6kxL1Cxm/hjhPoKp9T13xu6Vt807ertIffOy3B/pDl+3qrsCNq1pahrm",
+	"K9FFSoWA3w0KuomJOWWurG3PTjRwC6iK0WPkabqpE2n9oW+DR9UlD4i6iZ2DxyJNu2Dh9PqNgzM/BJKv",
+	"1GYj+Tmk5s+ePRb6eeuKEGoAikZHaeKi2SgPj921ykEGt5CVl4lcUIOgxek/XSpyl5JwKT88XirrnaTq",
+	"2mr7WSm/LZXyXJdw1xSarl6FQcH+DdyW9f1BcHBlNfAl1rnIOTDXuFN2zg27ouB+cIXye0atYh9S/grT",
+	"K5V8BFvWCRuXsPH6NZbcfDTkEIp8rnkKQ/YGb/VShtqwJU+B2YVWxRyzTsL4szzaJkPUQRq7KF1mJUUU",
+	"hORayETkPKOLa81kFvB0yF4B13YKLm1E94Vy0EKlAvNTqyE7qxZb3hvmunQSU8AC8pIt6CZUluINQEXx",
+	"tedTowPmQ3SzvYS7SSgqdgx3cz8YVv0+RIM2fQmeVFpCmesTeNsgpqwHZgC5YcLuGWYsXl+bFrMZNC9T",
+	"L4CnoGvPiD85MaAVDc5Po4cFn+2rrq2RmJsnJtlJMkEs9xlMueeSLK6J6fXZQiYQ3Zuw3+pIEfPsE7cG",
+	"ThzbBiBwvTCoYQ6E4KY/bsL83Gd1KtEpY9UN4+4k162wtPFAFmozcGmZjI6x+GM8fp/1q1nYG796PB+7",
+	"K4ZoZ2b1bQTT9CYbVYaNKpSb2vWUNRIh3f8ZbOuC03cEca15QlK54SsfOYnhyKu9OF0WDZybm+6Nr044",
+	"/AfK17TIPm7+gtTjQtr6sul3grTdS7H9J5DtPcaejRICHNv9hIcpkgSMwYhy9eeEj5smmJxc5q9O3S1E",
+	"BuUlX4z5p3h9ZEP4y0oslDB/J3tnzPdFpOtt5SO/7vTbKXEVLhPuWnL90R1OuJZpzAqZgcHDikLPYeIC",
+	"axIRCB7wn1I/jL5ers4DZwKbYAUvwjsa8sXKONQWkxPFu/Bh+ofs0mXCa7Lqw8k+lEANt4f0O1Wn4NKo",
+	"OMVf4h82qlM2xBBnDEZSocDLMa7bvCUwvlEzsJyu2PlpV2ri0hd2nFP/3vw75zO2R75nNcMe2Qc6cUEA",
+	"7tLE56cdf9gjEJfA03vEAZ3nTrKACLorDGc4v/lXkoc+ffM/h9ELbO/l8vD3sPlkAclHl+3EsaBMN+7C",
+	"+e8OGjrRVudGefhMUXzv36lENFMETJDDGQ2p+1fK0Xkn3IU+zx6JNMedtLV//y4Wy9H+p84dtuyCX29T",
+	"hIQc5BlPoM8gbAFz+zfTTE33v7hQdX1fMk/zu/LOBy6odW1tQ7Ddr4VRSxS9xo99bhzmuN4xpdbcRWru",
+	"c2HluJoZKWYz8K89AVj740pIMbJeFpkVeYY8sMC0SyAgT031k663grPJJb6Z+OxOTyT9MlPTh0XQKrEQ",
+	"TuJUN/kcrwIma5uhSKrfD3k2+jHkRjwoLhdMXRyFW71WxfYNLWu8EIZdvHv9+oEuLI5eHNxPaNnPcCvM",
+	"TNCNuA3M6avle6Xt/9ze1uxn9YvSGzwLEFe9exy/vCkYznLtaFSci0ArpBuF4uVP2mHjideeAd5BL/Wc",
+	"0vTuLiEdszfMUml96GBBSRLNxq98BgzE26I2ELtgiN9lG9Y73V3wC7rj5TK/Tvu/xscePO+nBz4nAKmh",
+	"X79kmViKlk3b8GoP1PatPk0DseFR8mf/0lA4mNi7dNypDqv/kODYExHAnIE0VyOni8cz9BOYjdRMr7Sf",
+	"Nn7Z99uiy5bw+rW0f014h0CNRqHTTyec7gbjl1wrqxKVrQ/3978slLHrwy8IYdb7PBf7twdRHN1yTV6T",
+	"Nm1R3d7xv2gQZSrhGT3eZPsrZaz0vgFvN/p7O7h+nKI9zLNno9FBZ4i3SluGl1sXIlk0BkEmEYDF8ns3",
+	"ol9Ie1S8HtcZ9HoBrGxOqJAnZQ7TLsDdAF2TTHsedlLCTnOrX8OqRN50/1cJ67hHwLZ17tX39o3WRg/a",
+	"5YB9qA93eBbs6G76fVj/7wA=",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
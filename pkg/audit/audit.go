@@ -0,0 +1,141 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// Operation is kind of change that was made to single item.
+type Operation string
+
+const (
+	OpCreate = Operation("create")
+	OpUpdate = Operation("update")
+	OpDelete = Operation("delete")
+	// OpRestore is undoing of soft delete
+	OpRestore = Operation("restore")
+	// OpPurge is physical delete of item, which can't be restored
+	OpPurge = Operation("purge")
+	// OpCommand is execution of named command, Entity of such record is name of command
+	// and After holds its parameters.
+	OpCommand = Operation("command")
+)
+
+// Record describes single change of single item.
+type Record struct {
+	Time      time.Time      `json:"time"`
+	Principal string         `json:"principal,omitempty"`
+	Backend   string         `json:"backend"`
+	Entity    string         `json:"entity"`
+	Operation Operation      `json:"operation"`
+	ID        interface{}    `json:"id,omitempty"`
+	Before    api.UntypedDto `json:"before,omitempty"`
+	After     api.UntypedDto `json:"after,omitempty"`
+	// Row is full, unredacted item after change (before it for delete and purge). It's never written to sinks,
+	// change events use it to evaluate filters and row policy of subscribers.
+	Row api.UntypedDto `json:"-"`
+}
+
+// Sink receives audit records after change was committed.
+type Sink interface {
+	Write(ctx context.Context, recs []*Record) error
+}
+
+// TxSink receives audit records within transaction that makes the change,
+// so that change is rolled back when records can't be written.
+type TxSink interface {
+	WriteTx(ctx context.Context, tx *sql.Tx, recs []*Record) error
+}
+
+// Auditor dispatches audit records to configured sinks.
+// Nil *Auditor is valid and means that auditing is disabled.
+type Auditor struct {
+	l       *slog.Logger
+	sinks   []Sink
+	txSinks []TxSink
+}
+
+// New creates Auditor from configuration, returns nil if cfg is nil.
+func New(cfg *types.AuditConfig, l *slog.Logger) (*Auditor, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	a := &Auditor{l: l}
+	for _, sc := range cfg.Sinks {
+		switch sc.Type {
+		case types.AuditSinkFile:
+			s, err := newFileSink(*sc.Path, int64(*sc.MaxSizeMB)<<20, *sc.MaxBackups)
+			if err != nil {
+				return nil, errors.Join(a.Close(), err)
+			}
+			a.sinks = append(a.sinks, s)
+		case types.AuditSinkLog:
+			a.sinks = append(a.sinks, &logSink{l: l})
+		case types.AuditSinkTable:
+			a.txSinks = append(a.txSinks, &tableSink{table: *sc.Table})
+		}
+	}
+	return a, nil
+}
+
+// WriteTx writes records to transactional sinks.
+func (a *Auditor) WriteTx(ctx context.Context, tx *sql.Tx, recs []*Record) error {
+	if a == nil || len(recs) == 0 {
+		return nil
+	}
+	for _, s := range a.txSinks {
+		if err := s.WriteTx(ctx, tx, recs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write writes records to non-transactional sinks. Since change is already committed at this point,
+// failures are only logged.
+func (a *Auditor) Write(ctx context.Context, recs []*Record) {
+	if a == nil || len(recs) == 0 {
+		return
+	}
+	for _, s := range a.sinks {
+		if err := s.Write(ctx, recs); err != nil {
+			a.l.ErrorContext(ctx, "unable to write audit records", "err", err, "count", len(recs))
+		}
+	}
+}
+
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+	var errs []error
+	for _, s := range a.sinks {
+		if c, ok := s.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// fileSink writes records as JSON lines. When file would grow over maxSize,
+// it's renamed to <path>.1 (existing backups are shifted) and new file is started.
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func newFileSink(path string, maxSize int64, maxBackups int) (*fileSink, error) {
+	fs := &fileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := fs.open(); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func (fs *fileSink) open() error {
+	f, err := os.OpenFile(fs.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	fs.f = f
+	fs.size = fi.Size()
+	return nil
+}
+
+func (fs *fileSink) rotate() error {
+	if err := fs.f.Close(); err != nil {
+		return err
+	}
+	if fs.maxBackups == 0 {
+		if err := os.Remove(fs.path); err != nil {
+			return err
+		}
+		return fs.open()
+	}
+	for i := fs.maxBackups - 1; i > 0; i-- {
+		src := fmt.Sprintf("%s.%d", fs.path, i)
+		if _, err := os.Stat(src); err == nil {
+			if err = os.Rename(src, fmt.Sprintf("%s.%d", fs.path, i+1)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Rename(fs.path, fs.path+".1"); err != nil {
+		return err
+	}
+	return fs.open()
+}
+
+func (fs *fileSink) Write(_ context.Context, recs []*Record) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for _, rec := range recs {
+		data, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+		if fs.size > 0 && fs.size+int64(len(data)) > fs.maxSize {
+			if err = fs.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := fs.f.Write(data)
+		fs.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs *fileSink) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.f.Close()
+}
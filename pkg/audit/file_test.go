@@ -0,0 +1,74 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func countLines(t *testing.T, file string) int {
+	f, err := os.Open(file)
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+	n := 0
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var rec Record
+		assert.NoError(t, json.Unmarshal(s.Bytes(), &rec))
+		n++
+	}
+	return n
+}
+
+func TestFileSink(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.jsonl")
+	rec := &Record{
+		Backend:   "demo",
+		Entity:    "emp",
+		Operation: OpUpdate,
+		ID:        "1",
+		Before:    api.UntypedDto{"name": "a"},
+		After:     api.UntypedDto{"name": "b"},
+	}
+	data, err := json.Marshal(rec)
+	assert.NoError(t, err)
+	lineLen := int64(len(data) + 1)
+
+	// room for 3 records per file
+	fs, err := newFileSink(file, 3*lineLen, 2)
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, fs.Write(context.Background(), []*Record{rec}))
+	}
+	assert.NoError(t, fs.Close())
+
+	assert.Equal(t, 1, countLines(t, file))
+	assert.Equal(t, 3, countLines(t, file+".1"))
+	assert.Equal(t, 3, countLines(t, file+".2"))
+	_, err = os.Stat(file + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
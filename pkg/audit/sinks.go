@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+)
+
+type logSink struct {
+	l *slog.Logger
+}
+
+func (ls *logSink) Write(ctx context.Context, recs []*Record) error {
+	for _, rec := range recs {
+		ls.l.InfoContext(ctx, "audit",
+			"principal", rec.Principal,
+			"backend", rec.Backend,
+			"entity", rec.Entity,
+			"operation", rec.Operation,
+			"id", rec.ID,
+			"before", rec.Before,
+			"after", rec.After,
+		)
+	}
+	return nil
+}
+
+// tableSink inserts records into audit table, see README for table layout.
+type tableSink struct {
+	table string
+}
+
+func jsonOrNil(v api.UntypedDto) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (ts *tableSink) WriteTx(ctx context.Context, tx *sql.Tx, recs []*Record) error {
+	qry := fmt.Sprintf("INSERT INTO `%s` (`ts`,`principal`,`backend`,`entity`,`operation`,`item_id`,`before_value`,`after_value`) "+
+		"VALUES(?,?,?,?,?,?,?,?)", ts.table)
+	for _, rec := range recs {
+		var (
+			before, after interface{}
+			err           error
+		)
+		if before, err = jsonOrNil(rec.Before); err != nil {
+			return err
+		}
+		if after, err = jsonOrNil(rec.After); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, qry, rec.Time, rec.Principal, rec.Backend, rec.Entity,
+			string(rec.Operation), fmt.Sprintf("%v", rec.ID), before, after); err != nil {
+			return fmt.Errorf("unable to write audit record: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+type apiKeyAuthenticator struct {
+	header string
+	// mapping from principal name to key
+	keys map[string]string
+}
+
+func newApiKeyAuthenticator(header string, keys map[string]string) Authenticator {
+	return &apiKeyAuthenticator{header: header, keys: keys}
+}
+
+func (a *apiKeyAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	key := r.Header.Get(a.header)
+	if len(key) == 0 {
+		return nil, nil
+	}
+	// don't bail out early to keep timing same for all keys
+	var found *Principal
+	for name, k := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			found = &Principal{Name: name}
+		}
+	}
+	if found == nil {
+		return nil, ErrBadCredentials
+	}
+	return found, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+type ctxKey struct{}
+
+var (
+	// ErrNoCredentials is returned when request does not carry any credentials, while they are required.
+	ErrNoCredentials = types.NewErrorWithStatus("authentication required", http.StatusUnauthorized)
+	// ErrBadCredentials is returned when credentials are present, but they are not valid.
+	ErrBadCredentials = types.NewErrorWithStatus("invalid credentials", http.StatusUnauthorized)
+)
+
+// Principal is authenticated identity of caller.
+type Principal struct {
+	// Name of principal, such as API key name or JWT subject
+	Name string
+	// Roles carried by credentials themselves, such as JWT roles claim
+	Roles []string
+	// Claims carried by credentials, if any
+	Claims map[string]interface{}
+}
+
+// NewContext returns copy of parent context that carries given principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext gets principal from context, or nil if there is none.
+func FromContext(ctx context.Context) *Principal {
+	if p, ok := ctx.Value(ctxKey{}).(*Principal); ok {
+		return p
+	}
+	return nil
+}
+
+// Authenticator establishes identity of caller from HTTP request.
+type Authenticator interface {
+	// Authenticate returns principal that made the request.
+	// (nil, nil) is returned when request carries no credentials recognized by this authenticator.
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+type chain []Authenticator
+
+func (c chain) Authenticate(r *http.Request) (*Principal, error) {
+	for _, a := range c {
+		if p, err := a.Authenticate(r); err != nil || p != nil {
+			return p, err
+		}
+	}
+	return nil, nil
+}
+
+// New creates Authenticator based on provided configuration.
+// Returned Authenticator tries all configured methods in order and the first one that recognizes credentials wins.
+// If cfg is nil, then nil is returned, meaning that authentication is disabled.
+func New(cfg *types.AuthConfig) (Authenticator, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	var c chain
+	if len(cfg.APIKeys) > 0 {
+		c = append(c, newApiKeyAuthenticator(*cfg.APIKeyHeader, cfg.APIKeys))
+	}
+	if cfg.JWT != nil {
+		a, err := newJwtAuthenticator(cfg.JWT)
+		if err != nil {
+			return nil, errors.Join(errors.New("unable to setup JWT authentication"), err)
+		}
+		c = append(c, a)
+	}
+	if cfg.ClientCert != nil {
+		c = append(c, newClientCertAuthenticator(cfg.ClientCert))
+	}
+	return c, nil
+}
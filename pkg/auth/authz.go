@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+type Operation string
+
+const (
+	OpList    = Operation("list")
+	OpGet     = Operation("get")
+	OpCreate  = Operation("create")
+	OpUpdate  = Operation("update")
+	OpDelete  = Operation("delete")
+	OpBulk    = Operation("bulk")
+	OpPurge   = Operation("purge") // physical delete of items of entity with soft delete
+	OpQuery   = Operation("query")
+	OpCommand = Operation("command")
+	OpAdmin   = Operation("admin") // administrative operations on backend, such as flushing of cache
+	opAny     = Operation("*")
+)
+
+var (
+	entityOps = []Operation{OpList, OpGet, OpCreate, OpUpdate, OpDelete, OpBulk, OpPurge}
+	knownOps  = append([]Operation{OpQuery, OpCommand, OpAdmin, opAny}, entityOps...)
+)
+
+type grant struct {
+	backends []string
+	entities []string
+	queries  []string
+	commands []string
+	ops      []Operation
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// allows checks that grant includes operation. Wildcard doesn't include OpPurge, which must be granted explicitly.
+func (g *grant) allows(op Operation) bool {
+	return (op != OpPurge && slices.Contains(g.ops, opAny)) || slices.Contains(g.ops, op)
+}
+
+// Authorizer decides whether principal may perform operation on backend resource.
+// Nil *Authorizer allows everything.
+type Authorizer struct {
+	roles      map[string][]*grant
+	principals map[string][]string
+	defRoles   []string
+}
+
+// NewAuthorizer creates Authorizer from configuration. If cfg is nil, then nil is returned.
+func NewAuthorizer(cfg *types.AuthorizationConfig) (*Authorizer, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	a := &Authorizer{
+		roles:      make(map[string][]*grant, len(cfg.Roles)),
+		principals: cfg.Principals,
+		defRoles:   cfg.DefaultRoles,
+	}
+	for role, grants := range cfg.Roles {
+		for _, g := range grants {
+			ng := &grant{backends: g.Backends, entities: g.Entities, queries: g.Queries, commands: g.Commands}
+			for _, op := range g.Operations {
+				if !slices.Contains(knownOps, Operation(op)) {
+					return nil, fmt.Errorf("unknown operation '%s' in role %s", op, role)
+				}
+				ng.ops = append(ng.ops, Operation(op))
+			}
+			a.roles[role] = append(a.roles[role], ng)
+		}
+	}
+	return a, nil
+}
+
+// grants returns all grants effective for principal.
+func (a *Authorizer) grants(p *Principal) []*grant {
+	roles := slices.Clone(a.defRoles)
+	if p != nil {
+		roles = append(roles, p.Roles...)
+		roles = append(roles, a.principals[p.Name]...)
+	}
+	var res []*grant
+	for _, role := range roles {
+		res = append(res, a.roles[role]...)
+	}
+	return res
+}
+
+func principalName(p *Principal) string {
+	if p == nil {
+		return "anonymous"
+	}
+	return p.Name
+}
+
+// Authorize checks that principal may perform operation on resource within backend.
+// Resource is name of entity, name of query for OpQuery or name of command for OpCommand.
+// OpAdmin is granted for whole backend, so resource is not matched.
+// Returned error carries HTTP status 403 and reason.
+func (a *Authorizer) Authorize(p *Principal, op Operation, backend, resource string) error {
+	if a == nil {
+		return nil
+	}
+	for _, g := range a.grants(p) {
+		if !g.allows(op) || !matchAny(g.backends, backend) {
+			continue
+		}
+		patterns := g.entities
+		switch op {
+		case OpQuery:
+			patterns = g.queries
+		case OpCommand:
+			patterns = g.commands
+		case OpAdmin:
+			return nil
+		}
+		if matchAny(patterns, resource) {
+			return nil
+		}
+	}
+	return types.NewErrorWithStatus(fmt.Sprintf("principal '%s' is not allowed to %s '%s' in backend '%s'",
+		principalName(p), op, resource, backend), http.StatusForbidden)
+}
+
+// CanSeeBackend checks whether principal has any grant on given backend.
+func (a *Authorizer) CanSeeBackend(p *Principal, backend string) bool {
+	if a == nil {
+		return true
+	}
+	for _, g := range a.grants(p) {
+		if matchAny(g.backends, backend) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanSeeEntity checks whether principal may perform any entity operation on given entity.
+func (a *Authorizer) CanSeeEntity(p *Principal, backend, entity string) bool {
+	if a == nil {
+		return true
+	}
+	for _, g := range a.grants(p) {
+		if matchAny(g.backends, backend) && matchAny(g.entities, entity) &&
+			slices.ContainsFunc(entityOps, g.allows) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer(t *testing.T) {
+	var (
+		a   *Authorizer
+		err error
+	)
+	a, err = NewAuthorizer(&types.AuthorizationConfig{
+		Principals: map[string][]string{
+			"alice": {"writer"},
+		},
+		DefaultRoles: []string{"reader"},
+		Roles: map[string][]*types.GrantConfig{
+			"reader": {
+				{Backends: []string{"demo"}, Entities: []string{"emp*"}, Queries: []string{"*"}, Operations: []string{"list", "get"}},
+			},
+			"writer": {
+				{Backends: []string{"*"}, Entities: []string{"*"}, Queries: []string{"*"}, Operations: []string{"*"}},
+			},
+			"admin": {
+				{Backends: []string{"demo"}, Entities: []string{"emp*"}, Operations: []string{"purge"}},
+			},
+			"reporting": {
+				{Backends: []string{"demo"}, Entities: []string{"*"}, Queries: []string{"report_*"}, Operations: []string{"query"}},
+				{Backends: []string{"demo"}, Commands: []string{"close_month"}, Operations: []string{"command"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	alice := &Principal{Name: "alice"}
+	bob := &Principal{Name: "bob"}
+	carol := &Principal{Name: "carol", Roles: []string{"reporting"}}
+
+	assert.NoError(t, a.Authorize(nil, OpList, "demo", "employees"))
+	assert.NoError(t, a.Authorize(bob, OpGet, "demo", "employees"))
+	err = a.Authorize(bob, OpDelete, "demo", "employees")
+	assert.Error(t, err)
+	se, ok := errors.AsType[*types.ErrorWithStatus](err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, se.Status)
+	assert.Contains(t, se.Msg, "bob")
+	assert.Error(t, a.Authorize(bob, OpList, "demo", "departments"))
+	assert.Error(t, a.Authorize(bob, OpList, "other", "employees"))
+	assert.NoError(t, a.Authorize(alice, OpDelete, "other", "departments"))
+	assert.NoError(t, a.Authorize(carol, OpQuery, "demo", "report_monthly"))
+	assert.Error(t, a.Authorize(carol, OpQuery, "demo", "salaries"))
+	assert.Error(t, a.Authorize(bob, OpQuery, "demo", "report_monthly"))
+	assert.NoError(t, a.Authorize(carol, OpCommand, "demo", "close_month"))
+	assert.Error(t, a.Authorize(carol, OpCommand, "demo", "archive_employee"))
+	assert.Error(t, a.Authorize(bob, OpCommand, "demo", "close_month"))
+	assert.NoError(t, a.Authorize(alice, OpAdmin, "demo", "cache"))
+	assert.Error(t, a.Authorize(carol, OpAdmin, "demo", "cache"))
+	// purge is never granted by wildcard
+	assert.Error(t, a.Authorize(alice, OpPurge, "demo", "employees"))
+	dave := &Principal{Name: "dave", Roles: []string{"admin"}}
+	assert.NoError(t, a.Authorize(dave, OpPurge, "demo", "employees"))
+	assert.Error(t, a.Authorize(dave, OpPurge, "demo", "departments"))
+	assert.Error(t, a.Authorize(dave, OpDelete, "demo", "employees"))
+
+	assert.True(t, a.CanSeeBackend(bob, "demo"))
+	assert.False(t, a.CanSeeBackend(bob, "other"))
+	assert.True(t, a.CanSeeBackend(alice, "other"))
+	assert.True(t, a.CanSeeEntity(bob, "demo", "employees"))
+	assert.False(t, a.CanSeeEntity(bob, "demo", "salaries"))
+
+	t.Run("nil authorizer allows everything", func(t *testing.T) {
+		var none *Authorizer
+		assert.NoError(t, none.Authorize(nil, OpDelete, "demo", "employees"))
+		assert.True(t, none.CanSeeBackend(nil, "demo"))
+		assert.True(t, none.CanSeeEntity(nil, "demo", "employees"))
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		_, err = NewAuthorizer(&types.AuthorizationConfig{
+			Roles: map[string][]*types.GrantConfig{
+				"x": {{Operations: []string{"truncate"}}},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"net/http"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+type clientCertAuthenticator struct {
+	subject string
+}
+
+func newClientCertAuthenticator(cfg *types.ClientCertConfig) Authenticator {
+	return &clientCertAuthenticator{subject: *cfg.Subject}
+}
+
+// Authenticate derives principal from leaf certificate of verified chain.
+// Certificates that were not verified by TLS stack are ignored.
+func (a *clientCertAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	p := &Principal{
+		Name: cert.Subject.CommonName,
+		Claims: map[string]interface{}{
+			"cn":    cert.Subject.CommonName,
+			"dn":    cert.Subject.String(),
+			"o":     cert.Subject.Organization,
+			"ou":    cert.Subject.OrganizationalUnit,
+			"dns":   cert.DNSNames,
+			"email": cert.EmailAddresses,
+		},
+	}
+	if a.subject == types.CertSubjectDN {
+		p.Name = cert.Subject.String()
+	}
+	if len(p.Name) == 0 {
+		return nil, ErrBadCredentials
+	}
+	return p, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// allowed clock skew when validating time-based claims
+const jwtLeeway = 30 * time.Second
+
+var (
+	errJwtMalformed = errors.New("malformed token")
+	errJwtAlg       = errors.New("unsupported signing algorithm")
+	errJwtSignature = errors.New("invalid token signature")
+
+	// ECDSA algorithm is bound to curve of key
+	jwtCurveAlgs = map[elliptic.Curve]string{
+		elliptic.P256(): "ES256",
+		elliptic.P384(): "ES384",
+		elliptic.P521(): "ES512",
+	}
+)
+
+type jwtAuthenticator struct {
+	cfg *types.JWTConfig
+	key interface{}
+	// signing algorithms accepted for configured key
+	algs []string
+	now  func() time.Time
+}
+
+func newJwtAuthenticator(cfg *types.JWTConfig) (Authenticator, error) {
+	a := &jwtAuthenticator{cfg: cfg, now: time.Now}
+	if cfg.Secret != nil {
+		a.key = []byte(*cfg.Secret)
+		a.algs = []string{"HS256", "HS384", "HS512"}
+		return a, nil
+	}
+	data, err := os.ReadFile(*cfg.PublicKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", *cfg.PublicKeyFile)
+	}
+	var key crypto.PublicKey
+	if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		a.algs = []string{"RS256", "RS384", "RS512"}
+	case *ecdsa.PublicKey:
+		alg, ok := jwtCurveAlgs[k.Curve]
+		if !ok {
+			return nil, fmt.Errorf("unsupported elliptic curve: %s", k.Curve.Params().Name)
+		}
+		a.algs = []string{alg}
+	default:
+		return nil, fmt.Errorf("unsupported public key type: %T", key)
+	}
+	a.key = key
+	return a, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	authz := r.Header.Get("Authorization")
+	token, ok := strings.CutPrefix(authz, "Bearer ")
+	if !ok {
+		return nil, nil
+	}
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, types.WrapErrorWithStatus(ErrBadCredentials.Error(), err, http.StatusUnauthorized)
+	}
+	p := &Principal{Claims: claims}
+	if p.Name, ok = claims[*a.cfg.SubjectClaim].(string); !ok || len(p.Name) == 0 {
+		return nil, types.NewErrorWithStatus("token has no subject", http.StatusUnauthorized)
+	}
+	if a.cfg.RolesClaim != nil {
+		p.Roles = claimAsStrings(claims[*a.cfg.RolesClaim])
+	}
+	return p, nil
+}
+
+// claimAsStrings accepts either JSON array of strings or single string with space-separated values.
+func claimAsStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var res []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+func (a *jwtAuthenticator) verify(token string) (map[string]interface{}, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithJSONNumber(),
+		jwt.WithLeeway(jwtLeeway),
+		jwt.WithTimeFunc(func() time.Time { return a.now() }),
+	}
+	if a.cfg.Issuer != nil {
+		opts = append(opts, jwt.WithIssuer(*a.cfg.Issuer))
+	}
+	if a.cfg.Audience != nil {
+		opts = append(opts, jwt.WithAudience(*a.cfg.Audience))
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.NewParser(opts...).ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if !slices.Contains(a.algs, t.Method.Alg()) {
+			return nil, errJwtAlg
+		}
+		return a.key, nil
+	})
+	switch {
+	case err == nil:
+		return claims, nil
+	case errors.Is(err, errJwtAlg):
+		return nil, errJwtAlg
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return nil, errJwtMalformed
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return nil, errJwtSignature
+	}
+	return nil, err
+}
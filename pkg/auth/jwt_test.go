@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func signHS256(t *testing.T, secret string, claims map[string]interface{}) string {
+	hdr := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	data, err := json.Marshal(claims)
+	assert.NoError(t, err)
+	signed := hdr + "." + base64.RawURLEncoding.EncodeToString(data)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func bearerRequest(token string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestJwtAuthenticator(t *testing.T) {
+	var (
+		a   Authenticator
+		p   *Principal
+		err error
+	)
+	a, err = New(&types.AuthConfig{
+		JWT: &types.JWTConfig{
+			Secret:       new("s3cr3t"),
+			Issuer:       new("idp"),
+			SubjectClaim: new("sub"),
+			RolesClaim:   new("roles"),
+		},
+	})
+	assert.NoError(t, err)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	p, err = a.Authenticate(bearerRequest(signHS256(t, "s3cr3t", map[string]interface{}{
+		"sub": "alice", "iss": "idp", "exp": exp, "roles": []string{"reader", "writer"}, "tenant": "acme",
+	})))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", p.Name)
+	assert.Equal(t, []string{"reader", "writer"}, p.Roles)
+	assert.Equal(t, "acme", p.Claims["tenant"])
+
+	t.Run("bad signature", func(t *testing.T) {
+		_, err = a.Authenticate(bearerRequest(signHS256(t, "other", map[string]interface{}{
+			"sub": "alice", "iss": "idp", "exp": exp,
+		})))
+		assert.ErrorIs(t, err, errJwtSignature)
+	})
+	t.Run("expired", func(t *testing.T) {
+		_, err = a.Authenticate(bearerRequest(signHS256(t, "s3cr3t", map[string]interface{}{
+			"sub": "alice", "iss": "idp", "exp": time.Now().Add(-time.Hour).Unix(),
+		})))
+		assert.Error(t, err)
+	})
+	t.Run("wrong issuer", func(t *testing.T) {
+		_, err = a.Authenticate(bearerRequest(signHS256(t, "s3cr3t", map[string]interface{}{
+			"sub": "alice", "iss": "evil", "exp": exp,
+		})))
+		assert.Error(t, err)
+	})
+	t.Run("alg none", func(t *testing.T) {
+		_, err = a.Authenticate(bearerRequest(base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) +
+			"." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"alice"}`)) + "."))
+		assert.ErrorIs(t, err, errJwtAlg)
+	})
+	t.Run("non-numeric expiration", func(t *testing.T) {
+		_, err = a.Authenticate(bearerRequest(signHS256(t, "s3cr3t", map[string]interface{}{
+			"sub": "alice", "iss": "idp", "exp": "never",
+		})))
+		assert.Error(t, err)
+		_, err = a.Authenticate(bearerRequest(signHS256(t, "s3cr3t", map[string]interface{}{
+			"sub": "alice", "iss": "idp", "exp": exp, "nbf": "now",
+		})))
+		assert.Error(t, err)
+	})
+	t.Run("no credentials", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/", nil)
+		p, err = a.Authenticate(r)
+		assert.NoError(t, err)
+		assert.Nil(t, p)
+	})
+}
+
+func TestJwtAuthenticatorECDSA(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	a, err := New(&types.AuthConfig{
+		JWT: &types.JWTConfig{PublicKeyFile: &keyFile, SubjectClaim: new("sub")},
+	})
+	assert.NoError(t, err)
+	claims := jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+	assert.NoError(t, err)
+
+	p, err := a.Authenticate(bearerRequest(token))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", p.Name)
+
+	t.Run("signature length", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+		// leading zero doesn't change value of r, but signature is no longer 2*32 bytes
+		padded := append(append([]byte{0}, sig[:32]...), append([]byte{0}, sig[32:]...)...)
+		_, err = a.Authenticate(bearerRequest(parts[0] + "." + parts[1] + "." + base64.RawURLEncoding.EncodeToString(padded)))
+		assert.ErrorIs(t, err, errJwtSignature)
+	})
+	t.Run("algorithm not matching curve", func(t *testing.T) {
+		other, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+		assert.NoError(t, err)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES512, claims).SignedString(other)
+		assert.NoError(t, err)
+		_, err = a.Authenticate(bearerRequest(token))
+		assert.ErrorIs(t, err, errJwtAlg)
+	})
+}
+
+func TestApiKeyAuthenticator(t *testing.T) {
+	a := newApiKeyAuthenticator("X-API-Key", map[string]string{"batch": "k1", "ui": "k2"})
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	p, err := a.Authenticate(r)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	r.Header.Set("X-API-Key", "k2")
+	p, err = a.Authenticate(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "ui", p.Name)
+	r.Header.Set("X-API-Key", "k3")
+	_, err = a.Authenticate(r)
+	assert.ErrorIs(t, err, ErrBadCredentials)
+}
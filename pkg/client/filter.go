@@ -33,10 +33,10 @@ func InExpressionAsPredicate[T any](ie query.InExpression, item *T) bool {
 	v := extractItemProperty(ie.Name(), item)
 	for _, val := range ie.Values() {
 		if cmp.Equal(val, v) {
-			return true
+			return ie.Op() != query.OpNotIn
 		}
 	}
-	return false
+	return ie.Op() == query.OpNotIn
 }
 
 func SimpleExpressionPredicate[T any](se query.SimpleExpression, item *T) bool {
@@ -80,6 +80,8 @@ func FilterExpressionAsPredicate[T any](fe query.FilterExpression, item *T) bool
 	switch fe.(type) {
 	case query.InExpression:
 		return InExpressionAsPredicate(fe.(query.InExpression), item)
+	case query.JSONPathExpression:
+		panic("unsupported filter expression: " + fe.String())
 	case query.SimpleExpression:
 		return SimpleExpressionPredicate[T](fe.(query.SimpleExpression), item)
 	case query.NotExpression:
@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"crypto/tls"
 	"log/slog"
 
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
@@ -37,6 +38,8 @@ type generic[T any] struct {
 	idProp string
 	// list of client options
 	copts []api.ClientOption
+	// optional TLS configuration
+	tlsCfg *tls.Config
 }
 
 func defaultEncoderFn[T any](obj *T) (api.UntypedDto, error) {
@@ -109,7 +112,11 @@ func New[T any](endpoint, backend, entity string, opts ...Opt[T]) (GenericInterf
 	}, opts...) {
 		opt(g)
 	}
-	c, err := api.NewClientWithResponses(endpoint, g.copts...)
+	copts := append([]api.ClientOption{}, g.copts...)
+	if g.tlsCfg != nil {
+		copts = append(copts, TLSClientOption(g.tlsCfg))
+	}
+	c, err := api.NewClientWithResponses(endpoint, copts...)
 	if err != nil {
 		return nil, err
 	}
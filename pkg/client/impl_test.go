@@ -102,3 +102,83 @@ func TestOpCreate(t *testing.T) {
 	assert.Equal(t, "Bob", res.Name)
 	assert.Equal(t, 43, res.Age)
 }
+
+func TestOpQueries(t *testing.T) {
+	Activate()
+	defer DeactivateAndReset()
+	RegisterResponder("GET", "http://loopback/dummy/_query",
+		NewJsonResponderOrPanic(http.StatusOK, []api.QueryInfo{
+			{
+				Name:        "by_dept",
+				Description: new("Employees of department"),
+				Params:      []api.QueryParamInfo{{Name: "dept", Type: "int", Required: true}},
+				Columns:     &[]api.ColumnInfo{{Name: "name", Type: "VARCHAR"}},
+				Permissions: api.QueryPermissions{Execute: true},
+			},
+		}))
+
+	cl, err := New[mockType]("http://loopback", "dummy", "mock",
+		WithClientOptions[mockType](api.WithHTTPClient(&mockDoer{})))
+	assert.NoError(t, err)
+	res, err := cl.Queries(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "by_dept", res[0].Name)
+	assert.Equal(t, "dept", res[0].Params[0].Name)
+	assert.True(t, res[0].Params[0].Required)
+	assert.Equal(t, "VARCHAR", (*res[0].Columns)[0].Type)
+	assert.True(t, res[0].Permissions.Execute)
+}
+
+func TestOpQueryWithFilter(t *testing.T) {
+	Activate()
+	defer DeactivateAndReset()
+	RegisterResponder("GET", "http://loopback/dummy/_query/grid",
+		func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			assert.Equal(t, []string{"name=desc"}, q["order[]"])
+			assert.Contains(t, q.Get("filter"), `"age"`)
+			assert.Equal(t, "7", q.Get("arg"))
+			return NewJsonResponse(http.StatusOK, api.PagedResult{TotalCount: lo.ToPtr(0)})
+		})
+
+	cl, err := New[mockType]("http://loopback", "dummy", "mock",
+		WithClientOptions[mockType](api.WithHTTPClient(&mockDoer{})))
+	assert.NoError(t, err)
+	res, err := cl.Query(context.Background(), "grid", query.NewBuilder().
+		Filter(query.SimpleExpr("age", query.OpGt, 30)).
+		OrderBy("name", false).
+		Build(), []string{"7"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, *res.TotalCount)
+}
+
+func TestOpSchema(t *testing.T) {
+	Activate()
+	defer DeactivateAndReset()
+	RegisterResponder("GET", "http://loopback/dummy/mock/_schema",
+		NewJsonResponderOrPanic(http.StatusOK, api.EntitySchema{
+			Name:        "mock",
+			IdColumn:    "id",
+			Columns:     []api.SchemaColumn{{Name: "id", Type: "INT", ColumnType: "int", AutoIncrement: true}},
+			PrimaryKey:  []string{"id"},
+			UniqueKeys:  []api.UniqueKey{},
+			ForeignKeys: []api.ForeignKey{},
+		}))
+	RegisterResponder("GET", "http://loopback/dummy/other/_schema",
+		NewStringResponder(http.StatusNotFound, "no such entity: other"))
+
+	cl, err := New[mockType]("http://loopback", "dummy", "mock",
+		WithClientOptions[mockType](api.WithHTTPClient(&mockDoer{})))
+	assert.NoError(t, err)
+	res, err := cl.Schema(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id"}, res.PrimaryKey)
+	assert.True(t, res.Columns[0].AutoIncrement)
+
+	cl, err = New[mockType]("http://loopback", "dummy", "other",
+		WithClientOptions[mockType](api.WithHTTPClient(&mockDoer{})))
+	assert.NoError(t, err)
+	_, err = cl.Schema(context.Background())
+	assert.ErrorContains(t, err, "no such entity")
+}
@@ -18,6 +18,10 @@ package client
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
 
 	dba "github.com/rkosegi/db2rest-bridge/pkg/api"
 	"github.com/rkosegi/db2rest-bridge/pkg/query"
@@ -80,3 +84,74 @@ func (i *imCrud[T]) BulkUpdate(_ context.Context, _ []*T, _ dba.BulkUpdateMode)
 func (i *imCrud[T]) Query(_ context.Context, _ string, _ query.Interface, _ []string) (*dba.PagedResult, error) {
 	panic("implement me")
 }
+
+// Command is not supported, there are no named commands for in-memory data.
+func (i *imCrud[T]) Command(_ context.Context, _ string, _ dba.UntypedDto) (*dba.CommandResult, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// Queries returns empty list, there are no named queries for in-memory data.
+func (i *imCrud[T]) Queries(_ context.Context) ([]dba.QueryInfo, error) {
+	return []dba.QueryInfo{}, nil
+}
+
+// Schema describes exported fields of T as columns. Field name is used as column name, unless overridden
+// by JSON tag. Only struct types can be described.
+func (i *imCrud[T]) Schema(_ context.Context) (*dba.EntitySchema, error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil, errors.ErrUnsupported
+	}
+	es := &dba.EntitySchema{
+		Name:        t.Name(),
+		Columns:     []dba.SchemaColumn{},
+		PrimaryKey:  []string{},
+		UniqueKeys:  []dba.UniqueKey{},
+		ForeignKeys: []dba.ForeignKey{},
+	}
+	for idx := range t.NumField() {
+		f := t.Field(idx)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		ft := f.Type
+		nullable := ft.Kind() == reflect.Pointer
+		if nullable {
+			ft = ft.Elem()
+		}
+		typ, colType := columnTypeOf(ft)
+		es.Columns = append(es.Columns, dba.SchemaColumn{
+			Name:       name,
+			Type:       typ,
+			ColumnType: colType,
+			Nullable:   nullable,
+		})
+	}
+	return es, nil
+}
+
+// columnTypeOf maps Go type to database type name and full column type.
+func columnTypeOf(t reflect.Type) (string, string) {
+	if t == reflect.TypeFor[time.Time]() {
+		return "DATETIME", "datetime"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "TEXT", "text"
+	case reflect.Bool:
+		return "TINYINT", "tinyint(1)"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "BIGINT", "bigint"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "BIGINT", "bigint unsigned"
+	case reflect.Float32, reflect.Float64:
+		return "DOUBLE", "double"
+	}
+	return "JSON", "json"
+}
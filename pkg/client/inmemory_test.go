@@ -17,8 +17,10 @@ limitations under the License.
 package client
 
 import (
+	"errors"
 	"testing"
 
+	dba "github.com/rkosegi/db2rest-bridge/pkg/api"
 	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
@@ -133,6 +135,12 @@ func TestInMemoryCrud(t *testing.T) {
 			Build())
 		assert.NoError(t, err)
 		assert.Equal(t, 1, total)
+
+		items, total, err = ic.List(t.Context(), query.NewBuilder().
+			Filter(query.NotIn("ExtID", []interface{}{12, 14, 16, 18})).
+			Build())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
 	})
 
 	t.Run("filter - junction expression", func(t *testing.T) {
@@ -168,6 +176,29 @@ func TestInMemoryCrud(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 99, emp.ExtID)
 	})
+
+	t.Run("named command", func(t *testing.T) {
+		_, err = ic.Command(t.Context(), "purge", nil)
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+
+	t.Run("named queries", func(t *testing.T) {
+		queries, err := ic.Queries(t.Context())
+		assert.NoError(t, err)
+		assert.Empty(t, queries)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		es, err := ic.Schema(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, "employee", es.Name)
+		assert.Len(t, es.Columns, 6)
+		assert.Equal(t, dba.SchemaColumn{Name: "Name", Type: "TEXT", ColumnType: "text"}, es.Columns[0])
+		assert.Equal(t, dba.SchemaColumn{Name: "Age", Type: "BIGINT", ColumnType: "bigint"}, es.Columns[3])
+
+		_, err = NewInMemory[int](nil, nil).Schema(t.Context())
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
 }
 
 func TestApplyPaging(t *testing.T) {
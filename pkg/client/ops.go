@@ -17,13 +17,13 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
 	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/rkosegi/db2rest-bridge/pkg/types"
-	"github.com/samber/lo"
 )
 
 func (g *generic[T]) List(ctx context.Context, qry query.Interface) ([]*T, int, error) {
@@ -84,7 +84,7 @@ func (g *generic[T]) Create(ctx context.Context, t *T) (*T, error) {
 }
 
 func (g *generic[T]) Get(ctx context.Context, id string) (*T, error) {
-	if resp, err := g.c.GetItemByIdWithResponse(ctx, g.be, g.ent, id); err != nil {
+	if resp, err := g.c.GetItemByIdWithResponse(ctx, g.be, g.ent, id, nil); err != nil {
 		return nil, err
 	} else {
 		switch resp.StatusCode() {
@@ -99,7 +99,7 @@ func (g *generic[T]) Get(ctx context.Context, id string) (*T, error) {
 }
 
 func (g *generic[T]) Delete(ctx context.Context, id string) error {
-	if resp, err := g.c.DeleteItemByIdWithResponse(ctx, g.be, g.ent, id); err != nil {
+	if resp, err := g.c.DeleteItemByIdWithResponse(ctx, g.be, g.ent, id, nil); err != nil {
 		return err
 	} else {
 		switch resp.StatusCode() {
@@ -164,14 +164,20 @@ func (g *generic[T]) BulkUpdate(ctx context.Context, objs []*T, mode api.BulkUpd
 func (g *generic[T]) Query(ctx context.Context, name string, qry query.Interface, args []string) (*api.PagedResult, error) {
 	var (
 		resp *api.QueryNamedResponse
+		lp   *api.ListItemsParams
 		err  error
 	)
-	if qry.Paging() == nil {
+	if qry == nil {
 		qry = query.DefaultQuery
 	}
+	if lp, err = query.ToParams(qry); err != nil {
+		return nil, err
+	}
 	if resp, err = g.c.QueryNamedWithResponse(ctx, g.be, name, &api.QueryNamedParams{
-		PageSize:   lo.ToPtr(qry.Paging().Size()),
-		PageOffset: lo.ToPtr(api.PageOffset(qry.Paging().Offset())),
+		PageSize:   lp.PageSize,
+		PageOffset: lp.PageOffset,
+		Order:      lp.Order,
+		Filter:     lp.Filter,
 		Arg:        &args,
 	}); err != nil {
 		return nil, err
@@ -185,3 +191,54 @@ func (g *generic[T]) Query(ctx context.Context, name string, qry query.Interface
 		return nil, errorFromResponse(resp.HTTPResponse)
 	}
 }
+
+func (g *generic[T]) Schema(ctx context.Context) (*api.EntitySchema, error) {
+	var (
+		resp *api.GetEntitySchemaResponse
+		err  error
+	)
+	if resp, err = g.c.GetEntitySchemaWithResponse(ctx, g.be, g.ent); err != nil {
+		return nil, err
+	}
+	switch resp.HTTPResponse.StatusCode {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	default:
+		return nil, errorFromResponseWithMsg(resp.HTTPResponse, string(bytes.TrimSpace(resp.Body)))
+	}
+}
+
+func (g *generic[T]) Queries(ctx context.Context) ([]api.QueryInfo, error) {
+	var (
+		resp *api.ListQueriesResponse
+		err  error
+	)
+	if resp, err = g.c.ListQueriesWithResponse(ctx, g.be); err != nil {
+		return nil, err
+	}
+	switch resp.HTTPResponse.StatusCode {
+	case http.StatusOK:
+		return *resp.JSON200, nil
+	default:
+		return nil, errorFromResponseWithMsg(resp.HTTPResponse, string(bytes.TrimSpace(resp.Body)))
+	}
+}
+
+func (g *generic[T]) Command(ctx context.Context, name string, params api.UntypedDto) (*api.CommandResult, error) {
+	var (
+		resp *api.ExecCommandResponse
+		err  error
+	)
+	if params == nil {
+		params = api.UntypedDto{}
+	}
+	if resp, err = g.c.ExecCommandWithResponse(ctx, g.be, name, params); err != nil {
+		return nil, err
+	}
+	switch resp.HTTPResponse.StatusCode {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	default:
+		return nil, errorFromResponseWithMsg(resp.HTTPResponse, string(bytes.TrimSpace(resp.Body)))
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+)
+
+// TLSOpt customizes TLS configuration created by NewTLSConfig.
+type TLSOpt func(*tls.Config) error
+
+// ClientCertificate presents certificate from given PEM files to server (mTLS).
+func ClientCertificate(certFile, keyFile string) TLSOpt {
+	return func(tc *tls.Config) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		tc.Certificates = append(tc.Certificates, cert)
+		return nil
+	}
+}
+
+// CustomCA verifies server certificate against CA bundle in given PEM file instead of system roots.
+func CustomCA(caFile string) TLSOpt {
+	return func(tc *tls.Config) error {
+		data, err := os.ReadFile(caFile)
+		if err != nil {
+			return err
+		}
+		if tc.RootCAs == nil {
+			tc.RootCAs = x509.NewCertPool()
+		}
+		if !tc.RootCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in %s", caFile)
+		}
+		return nil
+	}
+}
+
+// ServerName overrides name used to verify server certificate.
+func ServerName(name string) TLSOpt {
+	return func(tc *tls.Config) error {
+		tc.ServerName = name
+		return nil
+	}
+}
+
+// NewTLSConfig creates TLS configuration for client, TLS 1.2 is minimum version.
+func NewTLSConfig(opts ...TLSOpt) (*tls.Config, error) {
+	tc := &tls.Config{MinVersion: tls.VersionTLS12}
+	for _, opt := range opts {
+		if err := opt(tc); err != nil {
+			return nil, err
+		}
+	}
+	return tc, nil
+}
+
+// TLSClientOption creates API client option that uses HTTP client with given TLS configuration.
+func TLSClientOption(tc *tls.Config) api.ClientOption {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = tc
+	return api.WithHTTPClient(&http.Client{Transport: tr})
+}
+
+// WithTLSConfig makes client use given TLS configuration, see NewTLSConfig.
+// It takes precedence over HTTP client set via WithClientOptions.
+func WithTLSConfig[T any](tc *tls.Config) Opt[T] {
+	return func(g *generic[T]) {
+		g.tlsCfg = tc
+	}
+}
@@ -31,10 +31,16 @@ type GenericInterface[T any] interface {
 	Delete(context.Context, string) error
 	Update(context.Context, string, *T) (*T, error)
 	BulkUpdate(context.Context, []*T, api.BulkUpdateMode) error
+	// Schema gets columns and keys of entity
+	Schema(context.Context) (*api.EntitySchema, error)
 }
 
 type RawInterface interface {
 	Query(context.Context, string, query.Interface, []string) (*api.PagedResult, error)
+	// Queries lists named queries of backend that caller may run, along with their parameters and result columns
+	Queries(context.Context) ([]api.QueryInfo, error)
+	// Command executes named command with given parameters
+	Command(context.Context, string, api.UntypedDto) (*api.CommandResult, error)
 }
 
 type Opt[T any] func(*generic[T])
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"slices"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/samber/lo"
+)
+
+// table gets name of table backing entity.
+func (be *impl) table(entity string) string {
+	return be.config.Entity(entity).TableName(entity)
+}
+
+// entityOf gets name of entity backed by table.
+func (be *impl) entityOf(table string) string {
+	for name, ec := range be.config.Entities {
+		if ec != nil && ec.Table != nil && *ec.Table == table {
+			return name
+		}
+	}
+	return table
+}
+
+// toColumns gets copy of item with API field names translated to database columns.
+// Item itself is returned when entity has no column aliases.
+func (be *impl) toColumns(entity string, item api.UntypedDto) api.UntypedDto {
+	ec := be.config.Entity(entity)
+	if item == nil || ec == nil || len(ec.Columns) == 0 {
+		return item
+	}
+	return lo.MapKeys(item, func(_ interface{}, field string) string {
+		return ec.Column(field)
+	})
+}
+
+// toFields gets copy of item with database columns translated to API field names.
+// Item itself is returned when entity has no column aliases.
+func (be *impl) toFields(entity string, item api.UntypedDto) api.UntypedDto {
+	ec := be.config.Entity(entity)
+	if item == nil || ec == nil || len(ec.Columns) == 0 {
+		return item
+	}
+	return lo.MapKeys(item, func(_ interface{}, col string) string {
+		return ec.Field(col)
+	})
+}
+
+// columnsQuery gets copy of query that refers to database columns rather than API fields.
+func (be *impl) columnsQuery(entity string, qe query.Interface) query.Interface {
+	ec := be.config.Entity(entity)
+	if ec == nil || len(ec.Columns) == 0 {
+		return qe
+	}
+	return query.Rename(qe, ec.Column)
+}
+
+// apiSchema gets copy of schema that uses names of API, rather than those of database.
+// Managed columns are flagged as read-only.
+func (be *impl) apiSchema(es *api.EntitySchema) *api.EntitySchema {
+	ec := be.config.Entity(es.Name)
+	fields := func(ec *types.EntityConfig, cols []string) []string {
+		return lo.Map(cols, func(col string, _ int) string {
+			return ec.Field(col)
+		})
+	}
+	res := *es
+	res.IdColumn = ec.Field(es.IdColumn)
+	var managed []string
+	if ec != nil {
+		managed = ec.Managed.Columns()
+	}
+	res.Columns = lo.Map(es.Columns, func(sc api.SchemaColumn, _ int) api.SchemaColumn {
+		if slices.Contains(managed, sc.Name) {
+			sc.ReadOnly = new(true)
+		}
+		sc.Name = ec.Field(sc.Name)
+		return sc
+	})
+	res.PrimaryKey = fields(ec, es.PrimaryKey)
+	res.UniqueKeys = lo.Map(es.UniqueKeys, func(uk api.UniqueKey, _ int) api.UniqueKey {
+		uk.Columns = fields(ec, uk.Columns)
+		return uk
+	})
+	res.ForeignKeys = lo.Map(es.ForeignKeys, func(fk api.ForeignKey, _ int) api.ForeignKey {
+		fk.Columns = fields(ec, fk.Columns)
+		fk.RefEntity = be.entityOf(fk.RefEntity)
+		fk.RefColumns = fields(be.config.Entity(fk.RefEntity), fk.RefColumns)
+		return fk
+	})
+	return &res
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func aliasedBackend() *impl {
+	return &impl{config: &types.BackendConfig{
+		IdMap: &map[string]string{"employee": "EMP_ID"},
+		Entities: map[string]*types.EntityConfig{
+			"employee": {Table: new("TBL_EMP_V2"), Columns: map[string]string{"id": "EMP_ID", "name": "EMP_NM", "dept": "DEPT_ID"}},
+			"dept":     {Table: new("TBL_DEPT"), Columns: map[string]string{"code": "DEPT_CD"}},
+		},
+	}}
+}
+
+func TestAliasNames(t *testing.T) {
+	be := aliasedBackend()
+	assert.Equal(t, "TBL_EMP_V2", be.table("employee"))
+	assert.Equal(t, "salary", be.table("salary"))
+	assert.Equal(t, "`TBL_EMP_V2`", be.source("employee"))
+	assert.Equal(t, "employee", be.entityOf("TBL_EMP_V2"))
+	assert.Equal(t, "salary", be.entityOf("salary"))
+	assert.Equal(t, "id", be.config.IdField("employee"))
+	assert.Equal(t, "id", be.config.IdField("salary"))
+	// table of aliased entity is not entity on its own
+	assert.True(t, be.config.Shadowed("TBL_EMP_V2"))
+	assert.False(t, be.config.Shadowed("employee"))
+	assert.False(t, be.config.Shadowed("salary"))
+	assert.NoError(t, (&types.Config{Backends: types.Backends{"demo": be.config}}).CheckAndNormalize())
+	assert.ErrorContains(t, (&types.Config{Backends: types.Backends{"demo": {Entities: map[string]*types.EntityConfig{
+		"employees": {Table: new("emp")},
+		"emp":       {RowPolicy: new("1 = 1")},
+	}}}}).CheckAndNormalize(), "table emp is configured as another entity")
+
+	item := api.UntypedDto{"id": 1, "name": "John", "note": "x"}
+	assert.Equal(t, api.UntypedDto{"EMP_ID": 1, "EMP_NM": "John", "note": "x"}, be.toColumns("employee", item))
+	assert.Equal(t, item, be.toFields("employee", be.toColumns("employee", item)))
+	assert.Equal(t, item, be.toColumns("salary", item))
+	assert.Nil(t, be.toFields("employee", nil))
+
+	qe := be.columnsQuery("employee", query.NewBuilder().
+		Filter(query.Junction(query.OpAnd, query.SimpleExpr("name", query.OpEq, "John"), query.SimpleExpr("note", query.OpEq, "x"))).
+		OrderBy("dept", true).Build())
+	assert.Equal(t, "((EMP_NM = 'John') AND (note = 'x'))", qe.Filter().String())
+	assert.Equal(t, "DEPT_ID", qe.Orders()[0].Name())
+}
+
+func TestAliasSchema(t *testing.T) {
+	be := aliasedBackend()
+	es := be.apiSchema(&api.EntitySchema{
+		Name:       "employee",
+		IdColumn:   "EMP_ID",
+		Columns:    []api.SchemaColumn{{Name: "EMP_ID"}, {Name: "EMP_NM"}, {Name: "DEPT_ID"}},
+		PrimaryKey: []string{"EMP_ID"},
+		UniqueKeys: []api.UniqueKey{{Name: "uk_name", Columns: []string{"EMP_NM"}}},
+		ForeignKeys: []api.ForeignKey{
+			{Name: "fk_dept", Columns: []string{"DEPT_ID"}, RefEntity: "TBL_DEPT", RefColumns: []string{"DEPT_CD"}},
+		},
+	})
+	assert.Equal(t, "id", es.IdColumn)
+	assert.Equal(t, []api.SchemaColumn{{Name: "id"}, {Name: "name"}, {Name: "dept"}}, es.Columns)
+	assert.Equal(t, []string{"id"}, es.PrimaryKey)
+	assert.Equal(t, []string{"name"}, es.UniqueKeys[0].Columns)
+	assert.Equal(t, api.ForeignKey{Name: "fk_dept", Columns: []string{"dept"}, RefEntity: "dept", RefColumns: []string{"code"}},
+		es.ForeignKeys[0])
+
+	// masked columns refer to database, while records hold API fields
+	be.config.Entities["employee"].MaskedColumns = []string{"EMP_NM"}
+	be.auditor = &audit.Auditor{}
+	at := be.newTrail(t.Context(), "employee")
+	assert.Equal(t, api.UntypedDto{"id": 1, "name": maskedValue}, at.redact(api.UntypedDto{"id": 1, "name": "John"}))
+}
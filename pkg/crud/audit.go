@@ -0,0 +1,139 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/samber/lo"
+)
+
+const maskedValue = "***"
+
+// querier is common subset of *sql.DB and *sql.Tx
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// auditTrail collects audit records of single write operation.
+// Records also feed change events. Nil *auditTrail means that both auditing and publishing are disabled,
+// so callers can skip fetching of before/after values.
+type auditTrail struct {
+	rec    audit.Record
+	masked []string
+	recs   []*audit.Record
+}
+
+func (be *impl) newTrail(ctx context.Context, entity string) *auditTrail {
+	if be.auditor == nil && be.publisher == nil {
+		return nil
+	}
+	at := &auditTrail{rec: audit.Record{Backend: be.name, Entity: entity}}
+	if p := auth.FromContext(ctx); p != nil {
+		at.rec.Principal = p.Name
+	}
+	if ec := be.config.Entity(entity); ec != nil {
+		// records hold API fields
+		at.masked = lo.Map(ec.MaskedColumns, func(col string, _ int) string {
+			return ec.Field(col)
+		})
+	}
+	return at
+}
+
+// redact returns copy of item with masked columns replaced.
+func (at *auditTrail) redact(item api.UntypedDto) api.UntypedDto {
+	if item == nil {
+		return nil
+	}
+	res := make(api.UntypedDto, len(item))
+	for k, v := range item {
+		if v != nil && slices.Contains(at.masked, k) {
+			v = maskedValue
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// add records change of single item. Nothing is recorded when item neither existed nor exists.
+func (at *auditTrail) add(op audit.Operation, id interface{}, before, after api.UntypedDto) {
+	at.addPartial(op, id, before, after, lo.Ternary(after != nil, after, before))
+}
+
+// addPartial records change of single item whose before and after values don't hold whole item,
+// row is full item after change.
+func (at *auditTrail) addPartial(op audit.Operation, id interface{}, before, after, row api.UntypedDto) {
+	if at == nil || (before == nil && after == nil) {
+		return
+	}
+	rec := at.rec
+	rec.Time = time.Now().UTC()
+	rec.Operation = op
+	rec.ID = id
+	rec.Before = at.redact(before)
+	rec.After = at.redact(after)
+	rec.Row = row
+	at.recs = append(at.recs, &rec)
+}
+
+func (at *auditTrail) records() []*audit.Record {
+	if at == nil {
+		return nil
+	}
+	return at.recs
+}
+
+// withTx runs fn in transaction that writes to entity. Records collected in trail are written to transactional audit sinks
+// before commit and to other sinks after commit, cached responses affected by entity are invalidated
+// and change events are published after commit.
+// Empty entity means that written entities are not known upfront.
+// Whole transaction is subject to write timeout of backend.
+func (be *impl) withTx(ctx context.Context, entity string, at *auditTrail, fn func(ctx context.Context, tx *sql.Tx) error) (err error) {
+	ctx, done := be.deadline(ctx, kindWrite, be.config.WriteTimeout)
+	defer func() {
+		err = done(err)
+	}()
+	tx, err := be.config.DB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return err
+	}
+	if err = fn(ctx, tx); err == nil {
+		err = be.auditor.WriteTx(ctx, tx, at.records())
+	}
+	if err != nil {
+		be.l.ErrorContext(ctx, "query execution failed, rolling back", "err", err)
+		return errors.Join(err, tx.Rollback())
+	}
+	err = tx.Commit()
+	// commit might have succeeded even if error is reported
+	be.invalidate(entity)
+	if err != nil {
+		return err
+	}
+	be.auditor.Write(ctx, at.records())
+	be.publisher.Publish(ctx, at.records())
+	return nil
+}
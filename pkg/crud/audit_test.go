@@ -0,0 +1,62 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditTrail(t *testing.T) {
+	be := &impl{
+		name: "demo",
+		config: &types.BackendConfig{Entities: map[string]*types.EntityConfig{
+			"users": {MaskedColumns: []string{"password"}},
+		}},
+	}
+	assert.Nil(t, be.newTrail(context.Background(), "users"))
+
+	be.auditor = &audit.Auditor{}
+	at := be.newTrail(auth.NewContext(context.Background(), &auth.Principal{Name: "alice"}), "users")
+	before := api.UntypedDto{"id": 1, "name": "bob", "password": "secret"}
+	after := api.UntypedDto{"id": 1, "name": "bobby", "password": nil}
+	at.add(audit.OpUpdate, 1, before, after)
+	at.add(audit.OpDelete, 2, nil, nil)
+
+	recs := at.records()
+	assert.Len(t, recs, 1)
+	assert.Equal(t, "alice", recs[0].Principal)
+	assert.Equal(t, "demo", recs[0].Backend)
+	assert.Equal(t, "users", recs[0].Entity)
+	assert.Equal(t, maskedValue, recs[0].Before["password"])
+	assert.Nil(t, recs[0].After["password"])
+	assert.Equal(t, "bobby", recs[0].After["name"])
+	// original values are left intact
+	assert.Equal(t, "secret", before["password"])
+	// full row is kept aside for change events
+	assert.Equal(t, after, recs[0].Row)
+	at.add(audit.OpDelete, 1, before, nil)
+	assert.Equal(t, before, at.records()[1].Row)
+	at.addPartial(audit.OpUpdate, 1, nil, api.UntypedDto{"photo": "<3 bytes>"}, before)
+	assert.Equal(t, before, at.records()[2].Row)
+}
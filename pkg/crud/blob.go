@@ -0,0 +1,241 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// content of binary column is fetched in chunks of this size
+const blobChunkSize = 1 << 20
+
+// Blob is content of binary column, which is fetched from database as it's read.
+type Blob struct {
+	io.ReadSeekCloser
+	// Size of content in bytes
+	Size int64
+	// MIME type of content, empty when it's not known
+	ContentType string
+}
+
+// blobReader reads content of binary column within read-only transaction, so that all chunks come from same snapshot.
+type blobReader struct {
+	ctx context.Context
+	tx  *sql.Tx
+	// query that takes offset and length of chunk, followed by args
+	qry  string
+	args []interface{}
+	size int64
+	off  int64
+	// last fetched chunk, that starts at chunkOff
+	chunk    []byte
+	chunkOff int64
+}
+
+func (br *blobReader) Read(p []byte) (int, error) {
+	if br.off >= br.size {
+		return 0, io.EOF
+	}
+	if br.off < br.chunkOff || br.off >= br.chunkOff+int64(len(br.chunk)) {
+		var chunk []byte
+		// SUBSTRING is 1-based
+		args := append([]interface{}{br.off + 1, blobChunkSize}, br.args...)
+		if err := br.tx.QueryRowContext(br.ctx, br.qry, args...).Scan(&chunk); err != nil {
+			return 0, err
+		}
+		if len(chunk) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		br.chunk, br.chunkOff = chunk, br.off
+	}
+	n := copy(p, br.chunk[br.off-br.chunkOff:])
+	br.off += int64(n)
+	return n, nil
+}
+
+func (br *blobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += br.off
+	case io.SeekEnd:
+		offset += br.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	br.off = offset
+	return offset, nil
+}
+
+func (br *blobReader) Close() error {
+	return br.tx.Rollback()
+}
+
+// checkBlobColumns ensures that columns exist in entity, as their names are part of SQL.
+func (be *impl) checkBlobColumns(ctx context.Context, entity string, columns ...*string) error {
+	es, err := be.entitySchema(ctx, be.config.DB(), entity)
+	if err != nil {
+		return err
+	}
+	for _, col := range columns {
+		if col != nil && !slices.ContainsFunc(es.Columns, func(sc api.SchemaColumn) bool {
+			return sc.Name == *col
+		}) {
+			return types.NewErrorWithStatus(fmt.Sprintf("no such column in entity %s: %s", entity, be.config.Entity(entity).Field(*col)), http.StatusNotFound)
+		}
+	}
+	return nil
+}
+
+func (be *impl) OpenBlob(ctx context.Context, entity, id, field string) (_ *Blob, err error) {
+	if !*be.config.Read {
+		return nil, errReadNotAllowed
+	}
+	column := be.config.Entity(entity).Column(field)
+	bc := be.config.Entity(entity).Blob(column)
+	if err = be.checkBlobColumns(ctx, entity, &column, bc.ContentTypeColumn); err != nil {
+		return nil, err
+	}
+	if err = be.addressable(ctx, be.config.DB(), entity); err != nil {
+		return nil, err
+	}
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	preds := append(scope.predicates(), be.liveness(entity, deletedFrom(ctx))...)
+	filter := createSingleItemFilter(be.config.IdColumn(entity), preds...)
+	args := append([]interface{}{id}, predicateArgs(preds)...)
+
+	// transaction outlives this call, so it can't be bound to deadline of query
+	tx, err := be.config.DB().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, types.WrapError("failed to start transaction", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var (
+		size sql.NullInt64
+		ct   sql.NullString
+	)
+	dest := []interface{}{&size}
+	cols := fmt.Sprintf("LENGTH(`%s`)", column)
+	if bc.ContentTypeColumn != nil {
+		cols += fmt.Sprintf(", `%s`", *bc.ContentTypeColumn)
+		dest = append(dest, &ct)
+	}
+	qry := be.hint(fmt.Sprintf("SELECT %s FROM %s %s", cols, be.source(entity), filter), be.config.QueryTimeout)
+	be.l.Debug("SQL", "query", qry)
+	qctx, done := be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	err = done(tx.QueryRowContext(qctx, qry, args...).Scan(dest...))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, types.NewErrorWithStatus(fmt.Sprintf("entity of type '%s' with id '%s' was not found", entity, id), http.StatusNotFound)
+	case err != nil:
+		return nil, types.WrapError("failed to fetch size of content", err)
+	case !size.Valid:
+		err = types.NewErrorWithStatus(fmt.Sprintf("column %s of item '%s' is empty", field, id), http.StatusNotFound)
+		return nil, err
+	}
+	b := &Blob{
+		Size: size.Int64,
+		ReadSeekCloser: &blobReader{
+			ctx:  ctx,
+			tx:   tx,
+			qry:  fmt.Sprintf("SELECT SUBSTRING(`%s`, ?, ?) FROM %s %s", column, be.source(entity), filter),
+			args: args,
+			size: size.Int64,
+		},
+	}
+	if bc.ContentType != nil {
+		b.ContentType = *bc.ContentType
+	} else {
+		b.ContentType = ct.String
+	}
+	return b, nil
+}
+
+func (be *impl) WriteBlob(ctx context.Context, entity, id, field, contentType string, r io.Reader) error {
+	if !*be.config.Update {
+		return errUpdateNotAllowed
+	}
+	column := be.config.Entity(entity).Column(field)
+	if err := be.writable(entity); err != nil {
+		return err
+	}
+	bc := be.config.Entity(entity).Blob(column)
+	if err := be.checkBlobColumns(ctx, entity, &column, bc.ContentTypeColumn); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(r, bc.MaxSize()+1))
+	if err != nil {
+		return types.WrapErrorWithStatus("failed to read content", err, http.StatusBadRequest)
+	}
+	if int64(len(data)) > bc.MaxSize() {
+		return types.NewErrorWithStatus(fmt.Sprintf("content exceeds limit of %d MB", *bc.MaxSizeMB), http.StatusRequestEntityTooLarge)
+	}
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return err
+	}
+	body := api.UntypedDto{column: data}
+	if bc.ContentTypeColumn != nil {
+		body[*bc.ContentTypeColumn] = contentType
+	}
+	body = be.stamp(ctx, entity, scope.enforce(body), false)
+	preds := append(scope.predicates(), be.liveness(entity, ExcludeDeleted)...)
+	at := be.newTrail(ctx, entity)
+	return be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		if res, err := be.fetchOne(ctx, tx, entity, id, false, preds); err != nil {
+			return err
+		} else if res == nil {
+			return types.NewErrorWithStatus(fmt.Sprintf("entity of type '%s' with id '%s' was not found", entity, id), http.StatusNotFound)
+		}
+		qry, values := createUpdateQuery(be.table(entity), be.config.IdColumn(entity), body, preds...)
+		values = append(values, id)
+		values = append(values, predicateArgs(preds)...)
+		be.l.Debug("SQL", "query", qry)
+		if _, err := tx.ExecContext(ctx, qry, values...); err != nil {
+			return types.WrapError("failed to write content", err)
+		}
+		var row api.UntypedDto
+		if at != nil {
+			if row, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+				return err
+			}
+		}
+		// content itself is not worth recording
+		at.addPartial(audit.OpUpdate, id, nil, api.UntypedDto{field: fmt.Sprintf("<%d bytes>", len(data))}, row)
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"io"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobReader(t *testing.T) {
+	// whole content is already fetched, so no query is needed
+	br := &blobReader{size: 10, chunk: []byte("0123456789")}
+	buf := make([]byte, 4)
+	n, err := br.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123", string(buf[:n]))
+
+	pos, err := br.Seek(2, io.SeekCurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), pos)
+	n, err = br.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "6789", string(buf[:n]))
+	_, err = br.Read(buf)
+	assert.Equal(t, io.EOF, err)
+
+	pos, err = br.Seek(-3, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), pos)
+	data, err := io.ReadAll(br)
+	assert.NoError(t, err)
+	assert.Equal(t, "789", string(data))
+
+	pos, err = br.Seek(1, io.SeekStart)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), pos)
+
+	_, err = br.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+	_, err = br.Seek(0, 42)
+	assert.Error(t, err)
+
+	// past the end is allowed, it just reads nothing
+	_, err = br.Seek(20, io.SeekStart)
+	assert.NoError(t, err)
+	_, err = br.Read(buf)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestBlobConfig(t *testing.T) {
+	var ec *types.EntityConfig
+	bc := ec.Blob("data")
+	assert.Equal(t, int64(16<<20), bc.MaxSize())
+	assert.Nil(t, bc.ContentType)
+
+	ec = &types.EntityConfig{Blobs: map[string]*types.BlobConfig{
+		"photo": {ContentType: new("image/png"), MaxSizeMB: new(2)},
+	}}
+	assert.Equal(t, "image/png", *ec.Blob("photo").ContentType)
+	assert.Equal(t, int64(2<<20), ec.Blob("photo").MaxSize())
+	assert.Equal(t, int64(16<<20), ec.Blob("other").MaxSize())
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+const (
+	cacheKindEntity = "entity"
+	cacheKindQuery  = "query"
+)
+
+var (
+	cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "db2rest",
+		Name:      "cache_hits_total",
+		Help:      "Total number of responses served from cache.",
+	}, []string{"backend", "kind", "name"})
+	cacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "db2rest",
+		Name:      "cache_misses_total",
+		Help:      "Total number of cacheable responses that were not found in cache.",
+	}, []string{"backend", "kind", "name"})
+)
+
+type cachedResult struct {
+	res  *api.PagedResult
+	size uint64
+}
+
+// respCache caches paged results of single entity or named query.
+// Nil *respCache means that caching is disabled.
+type respCache struct {
+	c             *ttlcache.Cache[string, *cachedResult]
+	invalidatedBy []string
+	hits          prometheus.Counter
+	misses        prometheus.Counter
+}
+
+func newRespCache(backend, kind, name string, cfg *types.CacheConfig) *respCache {
+	c := ttlcache.New[string, *cachedResult](
+		ttlcache.WithTTL[string, *cachedResult](cfg.TTL),
+		ttlcache.WithDisableTouchOnHit[string, *cachedResult](),
+		ttlcache.WithMaxCost[string, *cachedResult](uint64(*cfg.MaxMemoryMB)<<20,
+			func(item ttlcache.CostItem[string, *cachedResult]) uint64 {
+				return uint64(len(item.Key)) + item.Value.size
+			}),
+	)
+	go c.Start()
+	return &respCache{
+		c:             c,
+		invalidatedBy: cfg.InvalidatedBy,
+		hits:          cacheHits.WithLabelValues(backend, kind, name),
+		misses:        cacheMisses.WithLabelValues(backend, kind, name),
+	}
+}
+
+// cacheKey computes key from normalized SQL and its arguments.
+// Row policies are rendered into SQL and arguments, so key covers scope of principal as well.
+func cacheKey(qry string, args []interface{}) string {
+	data, _ := json.Marshal(args)
+	h := sha256.New()
+	h.Write([]byte(qry))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (rc *respCache) get(key string) *api.PagedResult {
+	if rc == nil {
+		return nil
+	}
+	if item := rc.c.Get(key); item != nil {
+		rc.hits.Inc()
+		return item.Value().res
+	}
+	rc.misses.Inc()
+	return nil
+}
+
+func (rc *respCache) put(key string, res *api.PagedResult) {
+	if rc == nil {
+		return
+	}
+	data, _ := json.Marshal(res)
+	rc.c.Set(key, &cachedResult{res: res, size: uint64(len(data))}, ttlcache.DefaultTTL)
+}
+
+func (rc *respCache) flush() {
+	if rc != nil {
+		rc.c.DeleteAll()
+	}
+}
+
+func (rc *respCache) close() {
+	if rc != nil {
+		rc.c.Stop()
+	}
+}
+
+// invalidate drops cached responses that might be affected by write to entity.
+// Empty entity means that affected entities are unknown, so all caches are flushed.
+func (be *impl) invalidate(entity string) {
+	for name, rc := range be.entCaches {
+		if entity == "" || name == entity {
+			rc.flush()
+		}
+	}
+	for _, rc := range be.qryCaches {
+		if entity == "" || slices.Contains(rc.invalidatedBy, entity) {
+			rc.flush()
+		}
+	}
+}
+
+func (be *impl) FlushCache() {
+	be.invalidate("")
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheKey(t *testing.T) {
+	assert.Equal(t, cacheKey("SELECT 1", []interface{}{1, "a"}), cacheKey("SELECT 1", []interface{}{1, "a"}))
+	assert.NotEqual(t, cacheKey("SELECT 1", []interface{}{1, "a"}), cacheKey("SELECT 1", []interface{}{1, "b"}))
+	assert.NotEqual(t, cacheKey("SELECT 1", nil), cacheKey("SELECT 2", nil))
+}
+
+func TestRespCache(t *testing.T) {
+	var none *respCache
+	assert.Nil(t, none.get("x"))
+	none.put("x", &api.PagedResult{})
+	none.flush()
+
+	cfg := &types.CacheConfig{TTL: time.Minute, MaxMemoryMB: new(1), InvalidatedBy: []string{"emp"}}
+	be := &impl{
+		name:      "test-cache",
+		entCaches: map[string]*respCache{"emp": newRespCache("test-cache", cacheKindEntity, "emp", cfg)},
+		qryCaches: map[string]*respCache{
+			"by_dept": newRespCache("test-cache", cacheKindQuery, "by_dept", cfg),
+			"depts":   newRespCache("test-cache", cacheKindQuery, "depts", &types.CacheConfig{TTL: time.Minute, MaxMemoryMB: new(1)}),
+		},
+	}
+	defer func() {
+		_ = be.Close()
+	}()
+	res := &api.PagedResult{TotalCount: new(1), Data: &[]api.UntypedDto{{"name": "Alice"}}}
+	for _, rc := range be.qryCaches {
+		rc.put("k", res)
+	}
+	be.entCaches["emp"].put("k", res)
+	assert.Same(t, res, be.entCaches["emp"].get("k"))
+	assert.Nil(t, be.entCaches["emp"].get("other"))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cacheHits.WithLabelValues("test-cache", cacheKindEntity, "emp")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cacheMisses.WithLabelValues("test-cache", cacheKindEntity, "emp")))
+
+	be.invalidate("dept")
+	assert.NotNil(t, be.entCaches["emp"].get("k"))
+
+	be.invalidate("emp")
+	assert.Nil(t, be.entCaches["emp"].get("k"))
+	assert.Nil(t, be.qryCaches["by_dept"].get("k"))
+	assert.NotNil(t, be.qryCaches["depts"].get("k"))
+
+	be.FlushCache()
+	assert.Nil(t, be.qryCaches["depts"].get("k"))
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// statements that may produce result sets
+var queryStmtRE = regexp.MustCompile(`(?i)^\s*(SELECT|CALL)\b`)
+
+func compileCommand(cmd *types.Command) []*compiledQuery {
+	res := make([]*compiledQuery, len(cmd.Statements))
+	for i, stmt := range cmd.Statements {
+		res[i] = compileQuery(stmt, cmd.Params)
+	}
+	return res
+}
+
+// execStatement executes single statement of command. Statements that may produce result sets are executed as queries,
+// so that all result sets can be collected, other statements report number of affected rows.
+func (be *impl) execStatement(ctx context.Context, tx *sql.Tx, qry string, args []interface{}) (*api.StatementResult, error) {
+	be.l.Debug("SQL", "query", qry)
+	if !queryStmtRE.MatchString(qry) {
+		r, err := tx.ExecContext(ctx, qry, args...)
+		if err != nil {
+			return nil, err
+		}
+		affected, err := r.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		return &api.StatementResult{AffectedRows: int(affected)}, nil
+	}
+	rows, err := tx.QueryContext(ctx, qry, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	var sets [][]api.UntypedDto
+	for {
+		cols, colTypes, err := getRowMetadata(rows)
+		if err != nil {
+			return nil, types.WrapError("failed to get row metadata", err)
+		}
+		set := []api.UntypedDto{}
+		for rows.Next() {
+			item, err := be.tm.mapEntity(rows, cols, colTypes, nil)
+			if err != nil {
+				return nil, types.WrapError("failed to map row", err)
+			}
+			set = append(set, item)
+		}
+		if len(cols) > 0 {
+			sets = append(sets, set)
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	sr := &api.StatementResult{}
+	if len(sets) > 0 {
+		sr.ResultSets = &sets
+	}
+	return sr, nil
+}
+
+// readOutParams reads session variables that hold OUT parameters of called procedures.
+func (be *impl) readOutParams(ctx context.Context, tx *sql.Tx, names []string) (api.UntypedDto, error) {
+	cols := make([]string, len(names))
+	for i, n := range names {
+		cols[i] = "@" + n + " AS `" + n + "`"
+	}
+	qry := "SELECT " + strings.Join(cols, ", ")
+	be.l.Debug("SQL", "query", qry)
+	rows, err := be.fetchRows(ctx, tx, "", qry)
+	if err != nil {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+func (be *impl) ExecCommand(ctx context.Context, name string, args ...interface{}) (res *api.CommandResult, err error) {
+	cmd, ok := be.config.Commands[name]
+	if !ok {
+		return nil, types.NewErrorWithStatus("no such command: "+name, http.StatusNotFound)
+	}
+	bound, err := bindValues(cmd.Params, args)
+	if err != nil {
+		return nil, err
+	}
+	at := be.newTrail(ctx, name)
+	res = &api.CommandResult{Statements: make([]api.StatementResult, 0, len(cmd.Statements))}
+	err = be.withTx(ctx, "", at, func(ctx context.Context, tx *sql.Tx) error {
+		for _, cq := range be.commands[name] {
+			qry, qargs := cq.render(bound)
+			sr, err := be.execStatement(ctx, tx, qry, qargs)
+			if err != nil {
+				return err
+			}
+			res.AffectedRows += sr.AffectedRows
+			res.Statements = append(res.Statements, *sr)
+		}
+		if len(cmd.Out) > 0 {
+			out, err := be.readOutParams(ctx, tx, cmd.Out)
+			if err != nil {
+				return err
+			}
+			res.Out = &out
+		}
+		params := make(api.UntypedDto, len(bound))
+		for k, v := range bound {
+			if cmd.Params[k].Type == types.ParamList {
+				params[k] = v
+			} else {
+				params[k] = v[0]
+			}
+		}
+		at.add(audit.OpCommand, nil, nil, params)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/samber/lo"
+)
+
+// countPlaceholders counts positional placeholders outside of quoted strings and identifiers.
+func countPlaceholders(qry string) int {
+	var (
+		cnt   int
+		quote byte
+	)
+	for i := 0; i < len(qry); i++ {
+		c := qry[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '?':
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// describeParams converts parameter declarations to API form, sorted by name.
+func describeParams(params map[string]*types.QueryParam) []api.QueryParamInfo {
+	res := make([]api.QueryParamInfo, 0, len(params))
+	for name, p := range params {
+		pi := api.QueryParamInfo{
+			Name:     name,
+			Type:     p.Type,
+			ItemType: p.ItemType,
+			Required: *p.Required,
+			Default:  p.Default,
+			Pattern:  p.Pattern,
+		}
+		if p.Min != nil {
+			pi.Min = new(float32(*p.Min))
+		}
+		if p.Max != nil {
+			pi.Max = new(float32(*p.Max))
+		}
+		if len(p.Enum) > 0 {
+			pi.Enum = &p.Enum
+		}
+		res = append(res, pi)
+	}
+	slices.SortFunc(res, func(a, b api.QueryParamInfo) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// describeColumns gets columns of result set, without fetching any row.
+// Every parameter is bound to NULL, which is fine as no row is going to be produced anyway.
+func describeColumns(ctx context.Context, q querier, qry string, args []interface{}) ([]api.ColumnInfo, error) {
+	rows, err := q.QueryContext(ctx, fmt.Sprintf("SELECT * FROM (%s) AS probe LIMIT 0", qry), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	var colTypes []*sql.ColumnType
+	if _, colTypes, err = getRowMetadata(rows); err != nil {
+		return nil, err
+	}
+	return lo.Map(colTypes, func(ct *sql.ColumnType, _ int) api.ColumnInfo {
+		ci := api.ColumnInfo{Name: ct.Name(), Type: ct.DatabaseTypeName()}
+		if nullable, ok := ct.Nullable(); ok {
+			ci.Nullable = &nullable
+		}
+		return ci
+	}), nil
+}
+
+func (be *impl) DescribeQueries(ctx context.Context) (_ []api.QueryInfo, err error) {
+	if !*be.config.Read {
+		return nil, errReadNotAllowed
+	}
+	var done func(error) error
+	ctx, done = be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	defer func() {
+		err = done(err)
+	}()
+	names := lo.Keys(be.config.Queries)
+	slices.Sort(names)
+	res := make([]api.QueryInfo, 0, len(names))
+	for _, name := range names {
+		nq := be.config.Queries[name]
+		qi := api.QueryInfo{
+			Name:        name,
+			Description: nq.Description,
+			Params:      describeParams(nq.Params),
+		}
+		qry := nq.SQL
+		var args []interface{}
+		if cq, ok := be.queries[name]; ok {
+			bound := make(map[string][]interface{}, len(cq.params))
+			for pn := range cq.params {
+				bound[pn] = []interface{}{nil}
+			}
+			qry, args = cq.render(bound)
+		} else {
+			args = make([]interface{}, countPlaceholders(qry))
+		}
+		var cols []api.ColumnInfo
+		if cols, err = describeColumns(ctx, be.config.DB(), qry, args); err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			be.l.Warn("unable to determine columns of query", "query", name, "error", err)
+			err = nil
+		} else {
+			qi.Columns = &cols
+		}
+		res = append(res, qi)
+	}
+	return res, nil
+}
+
+// checkColumns ensures that all referenced columns are present in result set of named query.
+func (be *impl) checkColumns(ctx context.Context, name, qry string, args []interface{}, refs []string) error {
+	if len(refs) == 0 {
+		return nil
+	}
+	cols, err := describeColumns(ctx, be.config.DB(), qry, args)
+	if err != nil {
+		return types.WrapError("failed to determine columns of query "+name, err)
+	}
+	for _, ref := range refs {
+		if !slices.ContainsFunc(cols, func(ci api.ColumnInfo) bool {
+			return ci.Name == ref
+		}) {
+			return types.NewErrorWithStatus(fmt.Sprintf("unknown column '%s' in result of query %s", ref, name),
+				http.StatusBadRequest)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountPlaceholders(t *testing.T) {
+	assert.Equal(t, 0, countPlaceholders("SELECT 1"))
+	assert.Equal(t, 2, countPlaceholders("SELECT * FROM emp WHERE a = ? AND b = ?"))
+	assert.Equal(t, 1, countPlaceholders("SELECT '?', `a?`, \"it\\\"s?\" FROM t WHERE x = ?"))
+}
+
+func TestDescribeParams(t *testing.T) {
+	cq := testNamedQuery(t)
+	res := describeParams(cq.params)
+	assert.Len(t, res, 4)
+	assert.Equal(t, []string{"depts", "min_salary", "name", "since"},
+		[]string{res[0].Name, res[1].Name, res[2].Name, res[3].Name})
+	assert.Equal(t, types.ParamList, res[0].Type)
+	assert.Equal(t, types.ParamInt, *res[0].ItemType)
+	assert.Equal(t, float32(3), *res[0].Max)
+	assert.Equal(t, "0", *res[1].Default)
+	assert.False(t, res[1].Required)
+	assert.Equal(t, "^[A-Z]", *res[2].Pattern)
+	assert.True(t, res[3].Required)
+	assert.Nil(t, res[3].Enum)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+const (
+	wkbPoint              = 1
+	wkbLineString         = 2
+	wkbPolygon            = 3
+	wkbGeometryCollection = 7
+)
+
+var (
+	// GeoJSON type for every WKB geometry type, index is the WKB type
+	wkbTypes = []string{"", "Point", "LineString", "Polygon",
+		"MultiPoint", "MultiLineString", "MultiPolygon", "GeometryCollection"}
+
+	errWKBTruncated = errors.New("truncated WKB geometry")
+)
+
+type wkbReader struct {
+	data []byte
+	err  error
+}
+
+func (r *wkbReader) take(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.data) < n {
+		r.err = errWKBTruncated
+		return nil
+	}
+	res := r.data[:n]
+	r.data = r.data[n:]
+	return res
+}
+
+func (r *wkbReader) byteOrder() binary.ByteOrder {
+	if b := r.take(1); b != nil && b[0] == 0 {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+func (r *wkbReader) uint32(bo binary.ByteOrder) uint32 {
+	if b := r.take(4); b != nil {
+		return bo.Uint32(b)
+	}
+	return 0
+}
+
+// count reads number of items, each of them taking at least minSize bytes
+func (r *wkbReader) count(bo binary.ByteOrder, minSize int) int {
+	n := int(r.uint32(bo))
+	if r.err == nil && n*minSize > len(r.data) {
+		r.err = errWKBTruncated
+		return 0
+	}
+	return n
+}
+
+func (r *wkbReader) point(bo binary.ByteOrder) []interface{} {
+	if b := r.take(16); b != nil {
+		return []interface{}{math.Float64frombits(bo.Uint64(b)), math.Float64frombits(bo.Uint64(b[8:]))}
+	}
+	return nil
+}
+
+func (r *wkbReader) geometry() map[string]interface{} {
+	bo := r.byteOrder()
+	typ := r.uint32(bo)
+	if r.err != nil {
+		return nil
+	}
+	if typ < wkbPoint || typ > wkbGeometryCollection {
+		r.err = fmt.Errorf("unsupported WKB geometry type %d", typ)
+		return nil
+	}
+	if typ == wkbGeometryCollection {
+		n := r.count(bo, 5)
+		geoms := make([]interface{}, 0, n)
+		for i := 0; i < n && r.err == nil; i++ {
+			geoms = append(geoms, r.geometry())
+		}
+		return map[string]interface{}{"type": wkbTypes[typ], "geometries": geoms}
+	}
+	return map[string]interface{}{"type": wkbTypes[typ], "coordinates": r.coordinates(bo, typ)}
+}
+
+func (r *wkbReader) coordinates(bo binary.ByteOrder, typ uint32) interface{} {
+	if typ == wkbPoint {
+		return r.point(bo)
+	}
+	n := r.count(bo, 4)
+	res := make([]interface{}, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		switch typ {
+		case wkbLineString:
+			res = append(res, r.point(bo))
+		case wkbPolygon:
+			// ring is list of points, just like line string
+			res = append(res, r.coordinates(bo, wkbLineString))
+		default:
+			// members of multi-geometry carry their own header
+			mbo := r.byteOrder()
+			if mt := r.uint32(mbo); r.err == nil && mt != typ-3 {
+				r.err = fmt.Errorf("unexpected WKB geometry type %d within %s", mt, wkbTypes[typ])
+			}
+			res = append(res, r.coordinates(mbo, typ-3))
+		}
+	}
+	return res
+}
+
+// decodeGeometry converts value of spatial column, which is SRID followed by WKB, into GeoJSON geometry.
+// Non-zero SRID is kept in "srid" member.
+func decodeGeometry(data []byte) (map[string]interface{}, error) {
+	if len(data) < 4 {
+		return nil, errWKBTruncated
+	}
+	r := &wkbReader{data: data[4:]}
+	g := r.geometry()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if srid := binary.LittleEndian.Uint32(data); srid != 0 {
+		g["srid"] = srid
+	}
+	return g, nil
+}
+
+type wkbWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *wkbWriter) uint32(v uint32) {
+	w.buf.Write(binary.LittleEndian.AppendUint32(nil, v))
+}
+
+func (w *wkbWriter) header(typ uint32) {
+	w.buf.WriteByte(1)
+	w.uint32(typ)
+}
+
+func (w *wkbWriter) point(c interface{}) error {
+	p, ok := c.([]interface{})
+	if !ok || len(p) < 2 {
+		return fmt.Errorf("invalid position: %v", c)
+	}
+	for _, v := range p[:2] {
+		f, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("invalid position: %v", c)
+		}
+		w.buf.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(f)))
+	}
+	return nil
+}
+
+func (w *wkbWriter) geometry(g map[string]interface{}) error {
+	name, _ := g["type"].(string)
+	typ := slices.Index(wkbTypes, name)
+	if typ < wkbPoint {
+		return fmt.Errorf("unsupported geometry type: %v", g["type"])
+	}
+	w.header(uint32(typ))
+	if typ == wkbGeometryCollection {
+		geoms, ok := g["geometries"].([]interface{})
+		if !ok {
+			return fmt.Errorf("invalid geometries: %v", g["geometries"])
+		}
+		w.uint32(uint32(len(geoms)))
+		for _, item := range geoms {
+			gm, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid geometry: %v", item)
+			}
+			if err := w.geometry(gm); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return w.coordinates(uint32(typ), g["coordinates"])
+}
+
+func (w *wkbWriter) coordinates(typ uint32, c interface{}) error {
+	if typ == wkbPoint {
+		return w.point(c)
+	}
+	list, ok := c.([]interface{})
+	if !ok {
+		return fmt.Errorf("invalid coordinates: %v", c)
+	}
+	w.uint32(uint32(len(list)))
+	for _, item := range list {
+		var err error
+		switch typ {
+		case wkbLineString:
+			err = w.point(item)
+		case wkbPolygon:
+			err = w.coordinates(wkbLineString, item)
+		default:
+			w.header(typ - 3)
+			err = w.coordinates(typ-3, item)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeGeometry converts GeoJSON geometry into value of spatial column, see decodeGeometry.
+func encodeGeometry(g map[string]interface{}) ([]byte, error) {
+	w := &wkbWriter{}
+	srid, _ := g["srid"].(float64)
+	w.uint32(uint32(srid))
+	if err := w.geometry(g); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
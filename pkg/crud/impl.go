@@ -19,33 +19,55 @@ package crud
 import (
 	"context"
 	"database/sql"
-	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"net/http"
-	"strconv"
+	"slices"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/events"
 	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/rkosegi/db2rest-bridge/pkg/types"
 	"github.com/samber/lo"
+	"github.com/santhosh-tekuri/jsonschema/v6"
 )
 
 var (
-	errNoObj        = errors.New("require at least one object")
-	dateTimeLayouts = []string{time.RFC3339, time.DateOnly}
+	errNoObj = errors.New("require at least one object")
 )
 
 type impl struct {
-	io.Closer
 	config  *types.BackendConfig
 	l       *slog.Logger
 	mdCache *ttlcache.Cache[string, map[string]*sql.ColumnType]
+	// compiled row policies of entities
+	entPolicies map[string]*rowPolicy
+	// compiled row policies of named queries
+	qryPolicies map[string]*rowPolicy
+	// compiled named queries that declare parameters
+	queries map[string]*compiledQuery
+	// compiled statements of named commands
+	commands map[string][]*compiledQuery
+	// name of backend
+	name string
+	// optional auditor, nil when auditing is disabled
+	auditor *audit.Auditor
+	// optional publisher of change events, nil when publishing is disabled
+	publisher *events.Publisher
+	// response caches of entities and named queries, only those that have cache configured
+	entCaches map[string]*respCache
+	qryCaches map[string]*respCache
+	// compiled JSON schemas of write payloads, keyed by entity and operation
+	schemaCache *ttlcache.Cache[string, *jsonschema.Schema]
+	// schemas of entities as reported by information_schema
+	entSchemas *ttlcache.Cache[string, *api.EntitySchema]
+	tm         *typeMapper
 }
 
 type Opt func(*impl)
@@ -56,25 +78,106 @@ func WithLogger(l *slog.Logger) Opt {
 	}
 }
 
+// WithName sets name of backend, as it appears in API
+func WithName(name string) Opt {
+	return func(i *impl) {
+		i.name = name
+	}
+}
+
+// WithAuditor enables audit log of all data-changing operations
+func WithAuditor(a *audit.Auditor) Opt {
+	return func(i *impl) {
+		i.auditor = a
+	}
+}
+
+// WithPublisher enables publishing of change events after every committed write
+func WithPublisher(p *events.Publisher) Opt {
+	return func(i *impl) {
+		i.publisher = p
+	}
+}
+
 func newImpl(be *types.BackendConfig, opts ...Opt) Interface {
-	i := &impl{config: be}
+	i := &impl{
+		config:      be,
+		entPolicies: map[string]*rowPolicy{},
+		qryPolicies: map[string]*rowPolicy{},
+		queries:     map[string]*compiledQuery{},
+		commands:    map[string][]*compiledQuery{},
+		entCaches:   map[string]*respCache{},
+		qryCaches:   map[string]*respCache{},
+		tm:          newTypeMapper(be.TypeMapping),
+	}
 	for _, opt := range append([]Opt{
 		WithLogger(slog.Default()),
 	}, opts...) {
 		opt(i)
 	}
+	for name, ec := range be.Entities {
+		if ec.RowPolicy != nil {
+			i.entPolicies[name] = compileRowPolicy(*ec.RowPolicy)
+		}
+		if ec.Cache != nil {
+			i.entCaches[name] = newRespCache(i.name, cacheKindEntity, name, ec.Cache)
+		}
+	}
+	for name, nq := range be.Queries {
+		if nq.RowPolicy != nil {
+			i.qryPolicies[name] = compileRowPolicy(*nq.RowPolicy)
+		}
+		if len(nq.Params) > 0 {
+			i.queries[name] = compileQuery(nq.SQL, nq.Params)
+		}
+		if nq.Cache != nil {
+			i.qryCaches[name] = newRespCache(i.name, cacheKindQuery, name, nq.Cache)
+		}
+	}
+	for name, cmd := range be.Commands {
+		i.commands[name] = compileCommand(cmd)
+	}
 	i.mdCache = ttlcache.New[string, map[string]*sql.ColumnType](
 		ttlcache.WithTTL[string, map[string]*sql.ColumnType](1*time.Hour),
 		ttlcache.WithCapacity[string, map[string]*sql.ColumnType](250),
 		ttlcache.WithLoader[string, map[string]*sql.ColumnType](i),
 	)
 	go i.mdCache.Start()
+	i.schemaCache = ttlcache.New[string, *jsonschema.Schema](
+		ttlcache.WithTTL[string, *jsonschema.Schema](1*time.Hour),
+		ttlcache.WithCapacity[string, *jsonschema.Schema](500),
+	)
+	go i.schemaCache.Start()
+	i.entSchemas = ttlcache.New[string, *api.EntitySchema](
+		ttlcache.WithTTL[string, *api.EntitySchema](1*time.Hour),
+		ttlcache.WithCapacity[string, *api.EntitySchema](250),
+	)
+	go i.entSchemas.Start()
 	return i
 }
 
+func (be *impl) Close() error {
+	if be.mdCache != nil {
+		be.mdCache.Stop()
+	}
+	if be.schemaCache != nil {
+		be.schemaCache.Stop()
+	}
+	if be.entSchemas != nil {
+		be.entSchemas.Stop()
+	}
+	for _, rc := range be.entCaches {
+		rc.close()
+	}
+	for _, rc := range be.qryCaches {
+		rc.close()
+	}
+	return nil
+}
+
 func (be *impl) Load(c *ttlcache.Cache[string, map[string]*sql.ColumnType], key string) *ttlcache.Item[string, map[string]*sql.ColumnType] {
 	be.l.Debug("loading entity metadata into cache", "entity", key)
-	qry := createSingleSelectQuery(key, be.config.IdColumn(key))
+	qry := createSingleSelectFromQuery(be.source(key), be.config.IdColumn(key))
 	be.l.Debug("SQL", "query", qry)
 	rows, err := be.config.DB().Query(qry, "0")
 	if err != nil {
@@ -94,10 +197,39 @@ func (be *impl) Load(c *ttlcache.Cache[string, map[string]*sql.ColumnType], key
 	}), ttlcache.DefaultTTL)
 }
 
+// scope binds row policy of entity (if any) to principal associated with context.
+// Nil scope is returned for entities without row policy.
+func (be *impl) scope(ctx context.Context, entity string) (*rowScope, error) {
+	if rp, ok := be.entPolicies[entity]; ok {
+		return rp.bind(auth.FromContext(ctx))
+	}
+	return nil, nil
+}
+
 func (be *impl) fetchOneItem(ctx context.Context, entity, id string, retrieve bool) (res api.UntypedDto, err error) {
-	qry := createSingleSelectQuery(entity, be.config.IdColumn(entity))
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	ctx, done := be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	if err = be.addressable(ctx, be.config.DB(), entity); err != nil {
+		return nil, done(err)
+	}
+	res, err = be.fetchOne(ctx, be.config.DB(), entity, id, retrieve,
+		append(scope.predicates(), be.liveness(entity, deletedFrom(ctx))...))
+	return res, done(err)
+}
+
+// fetchOne fetches single item visible under given predicates, nil is returned if there is no such item.
+func (be *impl) fetchOne(ctx context.Context, q querier, entity string, id interface{}, retrieve bool, preds []*predicate) (res api.UntypedDto, err error) {
+	// resolved upfront, querier can't be used for another query while rows are open
+	var flags map[string]bool
+	if retrieve {
+		flags = be.flagColumns(ctx, q, entity)
+	}
+	qry := createSingleSelectFromQuery(be.source(entity), be.config.IdColumn(entity), preds...)
 	be.l.Debug("SQL", "query", qry)
-	rows, err := be.config.DB().QueryContext(ctx, qry, id)
+	rows, err := q.QueryContext(ctx, qry, append([]interface{}{id}, predicateArgs(preds)...)...)
 	if err != nil {
 		return nil, types.WrapError("failed to fetch single row", err)
 	}
@@ -114,7 +246,10 @@ func (be *impl) fetchOneItem(ctx context.Context, entity, id string, retrieve bo
 			if cols, colTypes, err = getRowMetadata(rows); err != nil {
 				return nil, types.WrapError("failed to get row metadata", err)
 			}
-			return mapEntity(rows, cols, colTypes)
+			if res, err = be.tm.mapEntity(rows, cols, colTypes, flags); err != nil {
+				return nil, err
+			}
+			return be.toFields(entity, res), nil
 		}
 
 		res = make(api.UntypedDto, 1)
@@ -122,60 +257,146 @@ func (be *impl) fetchOneItem(ctx context.Context, entity, id string, retrieve bo
 	return res, nil
 }
 
-func (be *impl) ListItems(ctx context.Context, entity string, qe query.Interface) (*api.PagedResult, error) {
+func (be *impl) ListItems(ctx context.Context, entity string, qe query.Interface) (_ *api.PagedResult, err error) {
 	if !*be.config.Read {
 		return nil, errReadNotAllowed
 	}
 	var (
-		cnt int
-		err error
-		qry string
+		cnt  int
+		qry  string
+		done func(error) error
 	)
+	ctx, done = be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	defer func() {
+		err = done(err)
+	}()
 
 	if qe == nil {
 		qe = query.DefaultQuery
 	}
-	whereExpr := ""
-	flt := qe.Filter()
-	if flt != nil {
-		whereExpr = fmt.Sprintf(" WHERE %v", flt)
+	if err = query.ValidateQuery(qe); err != nil {
+		return nil, types.WrapErrorWithStatus(err.Error(), err, http.StatusBadRequest)
+	}
+	qe = be.columnsQuery(entity, qe)
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	ft, err := be.fullText(entity, qe.Search())
+	if err != nil {
+		return nil, err
 	}
-	qry = fmt.Sprintf("SELECT COUNT(1) FROM `%s`%s", entity, whereExpr)
+	preds := append(scope.predicates(), be.liveness(entity, deletedFrom(ctx))...)
+	columns := "*"
+	// score expression precedes WHERE clause, so its args come first
+	var dataArgs []interface{}
+	if ft != nil {
+		preds = append(preds, ft.cond)
+		if qe.Search().Score || orderedByScore(qe) {
+			columns = fmt.Sprintf("*, %s AS `%s`", ft.score.sql, query.ScoreColumn)
+			dataArgs = append(dataArgs, ft.score.args...)
+		}
+	} else if orderedByScore(qe) {
+		return nil, types.NewErrorWithStatus("ordering by "+query.ScoreColumn+" requires search", http.StatusBadRequest)
+	}
+	whereExpr, args := createWhereClause(qe.Filter(), preds...)
+	dataArgs = append(dataArgs, args...)
+	dataQry := be.hint(fmt.Sprintf("SELECT %s FROM %s%s%s", columns, be.source(entity), whereExpr, createOrderAndLimit(qe)),
+		be.config.QueryTimeout)
+	rc := be.entCaches[entity]
+	key := cacheKey(dataQry, dataArgs)
+	if cached := rc.get(key); cached != nil {
+		return cached, nil
+	}
+	qry = be.hint(fmt.Sprintf("SELECT COUNT(1) FROM %s%s", be.source(entity), whereExpr), be.config.QueryTimeout)
 	be.l.Debug("SQL", "query", qry)
-	row := be.config.DB().QueryRowContext(ctx, qry)
+	row := be.config.DB().QueryRowContext(ctx, qry, args...)
 	if err = row.Scan(&cnt); err != nil {
 		return nil, types.WrapError("failed to determine resultset size", err)
 	}
 	res := []api.UntypedDto{}
 	if cnt > 0 {
-		qry = fmt.Sprintf("SELECT * FROM `%s`%s", entity, qe.String())
-		be.l.Debug("SQL", "query", qry)
-		if res, err = be.fetchRows(ctx, qry); err != nil {
+		be.l.Debug("SQL", "query", dataQry)
+		if res, err = be.fetchRows(ctx, be.config.DB(), entity, dataQry, dataArgs...); err != nil {
 			return nil, types.WrapError("failed to fetch rows", err)
 		}
 	}
-	return &api.PagedResult{
+	if ft != nil && !qe.Search().Score {
+		// score was only needed for ordering
+		for _, item := range res {
+			delete(item, query.ScoreColumn)
+		}
+	}
+	result := &api.PagedResult{
 		Data:       &res,
 		TotalCount: &cnt,
 		Offset:     lo.ToPtr(float32(qe.Paging().Offset())),
-	}, nil
+	}
+	rc.put(key, result)
+	return result, nil
 }
 
-func (be *impl) QueryNamed(ctx context.Context, name string, qry query.Interface, args ...interface{}) (*api.PagedResult, error) {
+func (be *impl) QueryNamed(ctx context.Context, name string, qry query.Interface, args ...interface{}) (_ *api.PagedResult, err error) {
 	if !*be.config.Read {
 		return nil, errReadNotAllowed
 	}
 	if qry == nil {
 		qry = query.DefaultQuery
 	}
-	var err error
+	if qry.Search() != nil {
+		return nil, types.NewErrorWithStatus("full-text search is not supported by named queries", http.StatusBadRequest)
+	}
 	items := make([]api.UntypedDto, 0)
-	savedQry, ok := be.config.Queries[name]
+	nq, ok := be.config.Queries[name]
 	if !ok {
 		return nil, types.NewErrorWithStatus("no such query: "+name, http.StatusNotFound)
 	}
+	timeout := nq.EffectiveTimeout(be.config)
+	var done func(error) error
+	ctx, done = be.deadline(ctx, kindRead, timeout)
+	defer func() {
+		err = done(err)
+	}()
+	savedQry := nq.SQL
+	if cq, ok := be.queries[name]; ok {
+		if savedQry, args, err = cq.bind(args); err != nil {
+			return nil, err
+		}
+	} else if slices.ContainsFunc(args, func(arg interface{}) bool {
+		_, named := arg.(sql.NamedArg)
+		return named
+	}) {
+		return nil, types.NewErrorWithStatus("query "+name+" does not declare any parameters", http.StatusBadRequest)
+	}
+	if err = query.ValidateQuery(qry); err != nil {
+		return nil, types.WrapErrorWithStatus(err.Error(), err, http.StatusBadRequest)
+	}
+	namedQry, namedArgs := savedQry, args
+	var preds []*predicate
+	if rp, ok := be.qryPolicies[name]; ok {
+		var scope *rowScope
+		if scope, err = rp.bind(auth.FromContext(ctx)); err != nil {
+			return nil, err
+		}
+		preds = append(preds, scope.pred)
+	}
+	whereExpr, predArgs := createWhereClause(qry.Filter(), preds...)
+	if whereExpr != "" || len(qry.Orders()) > 0 {
+		savedQry = fmt.Sprintf("SELECT * FROM (%s) AS named%s", savedQry, whereExpr)
+		args = append(args, predArgs...)
+	}
 
-	countQry := fmt.Sprintf("SELECT COUNT(1) FROM (%s) AS wrapper", savedQry)
+	dataQry := be.hint(savedQry+createOrderAndLimit(qry), timeout)
+	rc := be.qryCaches[name]
+	key := cacheKey(dataQry, args)
+	if cached := rc.get(key); cached != nil {
+		return cached, nil
+	}
+	if err = be.checkColumns(ctx, name, namedQry, namedArgs, query.Columns(qry)); err != nil {
+		return nil, err
+	}
+
+	countQry := be.hint(fmt.Sprintf("SELECT COUNT(1) FROM (%s) AS wrapper", savedQry), timeout)
 	be.l.Debug("SQL", "query", countQry)
 	row := be.config.DB().QueryRowContext(ctx, countQry, args...)
 	var cnt int
@@ -185,23 +406,30 @@ func (be *impl) QueryNamed(ctx context.Context, name string, qry query.Interface
 
 	var offset uint64
 	if qry.Paging() != nil {
-		savedQry += " LIMIT " + qry.Paging().String()
 		offset = qry.Paging().Offset()
 	}
-
-	be.l.Debug("SQL", "query", savedQry)
-	if items, err = be.fetchRows(ctx, savedQry, args...); err != nil {
+	be.l.Debug("SQL", "query", dataQry)
+	if items, err = be.fetchRows(ctx, be.config.DB(), "", dataQry, args...); err != nil {
 		return nil, types.WrapError("failed to execute query "+name, err)
 	}
-	return &api.PagedResult{
+	result := &api.PagedResult{
 		TotalCount: &cnt,
 		Data:       &items,
 		Offset:     lo.ToPtr(float32(offset)),
-	}, nil
+	}
+	rc.put(key, result)
+	return result, nil
 }
 
-func (be *impl) fetchRows(ctx context.Context, qry string, args ...interface{}) ([]api.UntypedDto, error) {
-	rows, err := be.config.DB().QueryContext(ctx, qry, args...)
+// fetchRows fetches all rows of query. Entity is used to identify flag columns and to translate column names
+// to API fields, it's empty for ad-hoc queries.
+func (be *impl) fetchRows(ctx context.Context, q querier, entity, qry string, args ...interface{}) ([]api.UntypedDto, error) {
+	// resolved upfront, querier can't be used for another query while rows are open
+	var flags map[string]bool
+	if entity != "" {
+		flags = be.flagColumns(ctx, q, entity)
+	}
+	rows, err := q.QueryContext(ctx, qry, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -220,22 +448,23 @@ func (be *impl) fetchRows(ctx context.Context, qry string, args ...interface{})
 	res := []api.UntypedDto{}
 	for rows.Next() {
 		var item api.UntypedDto
-		if item, err = mapEntity(rows, cols, colTypes); err != nil {
+		if item, err = be.tm.mapEntity(rows, cols, colTypes, flags); err != nil {
 			return nil, types.WrapError("failed to map row to entity", err)
 		}
-		res = append(res, item)
+		res = append(res, be.toFields(entity, item))
 	}
 	return res, nil
 }
 
-func (be *impl) ListEntities(ctx context.Context) ([]string, error) {
+func (be *impl) ListEntities(ctx context.Context) (res []string, err error) {
 	if !*be.config.Read {
 		return nil, errReadNotAllowed
 	}
-	var (
-		err error
-		res []string
-	)
+	var done func(error) error
+	ctx, done = be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	defer func() {
+		err = done(err)
+	}()
 	qry := "SHOW TABLES"
 	be.l.Debug("SQL", "query", qry)
 	rows, err := be.config.DB().QueryContext(ctx, qry)
@@ -250,9 +479,16 @@ func (be *impl) ListEntities(ctx context.Context) ([]string, error) {
 		if err = rows.Scan(&t); err != nil {
 			return nil, types.WrapError("failed to scan table name", err)
 		}
-		res = append(res, t)
+		res = append(res, be.entityOf(t))
 	}
-	return res, nil
+	var virtual []string
+	for name, ec := range be.config.Entities {
+		if ec.Virtual() {
+			virtual = append(virtual, name)
+		}
+	}
+	slices.Sort(virtual)
+	return append(res, virtual...), nil
 }
 
 func (be *impl) Exists(ctx context.Context, entity, id string) (bool, error) {
@@ -270,175 +506,343 @@ func (be *impl) Get(ctx context.Context, entity, id string) (res api.UntypedDto,
 	return be.fetchOneItem(ctx, entity, id, true)
 }
 
-func (be *impl) Delete(ctx context.Context, entity, id string) (err error) {
+func (be *impl) Delete(ctx context.Context, entity, id string) error {
+	return be.delete(ctx, entity, id, false)
+}
+
+func (be *impl) Purge(ctx context.Context, entity, id string) error {
+	return be.delete(ctx, entity, id, true)
+}
+
+// delete removes single item, or just marks it as deleted when entity has soft delete and purge is not requested.
+func (be *impl) delete(ctx context.Context, entity, id string, purge bool) (err error) {
 	if !*be.config.Delete {
 		return errDeleteNotAllowed
 	}
-	qry := createSingleDeleteQuery(entity, be.config.IdColumn(entity))
-	be.l.Debug("SQL", "query", qry)
-	_, err = be.config.DB().ExecContext(ctx, qry, id)
-	return err
+	if err = be.writable(entity); err != nil {
+		return err
+	}
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return err
+	}
+	preds := scope.predicates()
+	sd := be.config.Entity(entity).SoftDelete
+	if purge {
+		sd = nil
+	} else {
+		preds = append(preds, be.liveness(entity, ExcludeDeleted)...)
+	}
+	at := be.newTrail(ctx, entity)
+	return be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		var before api.UntypedDto
+		if at != nil {
+			if before, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+				return err
+			}
+		}
+		qry, args := createSingleDeleteQuery(be.table(entity), be.config.IdColumn(entity), preds...), []interface{}{id}
+		if sd != nil {
+			qry = createMarkQuery(be.table(entity), sd.Column, createSingleItemFilter(be.config.IdColumn(entity), preds...))
+			args = []interface{}{deletedMarker(sd, true), id}
+		}
+		be.l.Debug("SQL", "query", qry)
+		if _, err = tx.ExecContext(ctx, qry, append(args, predicateArgs(preds)...)...); err != nil {
+			return err
+		}
+		op := audit.OpDelete
+		if purge {
+			op = audit.OpPurge
+		}
+		at.add(op, id, before, nil)
+		return nil
+	})
 }
 
-func (be *impl) Update(ctx context.Context, entity, id string, body api.UntypedDto) (api.UntypedDto, error) {
+func (be *impl) Update(ctx context.Context, entity, id string, body api.UntypedDto) (res api.UntypedDto, err error) {
 	if !*be.config.Update {
 		return nil, errUpdateNotAllowed
 	}
-	md := be.mdCache.Get(entity)
-	if md != nil {
-		body = remapBody(md, body)
+	if err = be.writable(entity); err != nil {
+		return nil, err
 	}
-	qry, values := createUpdateQuery(entity, be.config.IdColumn(entity), body)
-	values = append(values, id)
-	be.l.Debug("SQL", "query", qry)
-	if _, err := be.config.DB().ExecContext(ctx, qry, values...); err != nil {
-		return nil, types.WrapError("failed to update entity", err)
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return nil, err
 	}
-	return be.fetchOneItem(ctx, entity, id, true)
-}
-
-func remapValue(v interface{}, ct *sql.ColumnType) interface{} {
-	switch v := v.(type) {
-	case string:
-		if ct.DatabaseTypeName() == "DATETIME" || ct.DatabaseTypeName() == "TIMESTAMP" || ct.DatabaseTypeName() == "DATE" {
-			for _, layout := range dateTimeLayouts {
-				t, err := time.Parse(layout, v)
-				if err == nil {
-					return t
-				}
+	if err = be.validate(ctx, entity, payloadUpdate, false, body); err != nil {
+		return nil, err
+	}
+	body = be.toColumns(entity, body)
+	md := be.mdCache.Get(entity)
+	if md != nil {
+		body = be.tm.remapBody(md, body)
+	}
+	body = be.stamp(ctx, entity, scope.enforce(body), false)
+	preds := append(scope.predicates(), be.liveness(entity, ExcludeDeleted)...)
+	at := be.newTrail(ctx, entity)
+	err = be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		var before api.UntypedDto
+		if at != nil || len(scope.predicates()) > 0 {
+			if before, err = be.fetchOne(ctx, tx, entity, id, at != nil, preds); err != nil {
+				return err
 			}
-			return v
 		}
-		if ct.DatabaseTypeName() == "BLOB" {
-			bytes, err := base64.StdEncoding.DecodeString(v)
-			if err != nil {
-				return v
+		qry, values := createUpdateQuery(be.table(entity), be.config.IdColumn(entity), body, preds...)
+		values = append(values, id)
+		values = append(values, predicateArgs(preds)...)
+		be.l.Debug("SQL", "query", qry)
+		if _, err = tx.ExecContext(ctx, qry, values...); err != nil {
+			return types.WrapError("failed to update entity", err)
+		}
+		// item that wasn't visible is left intact
+		if before != nil {
+			if err = be.checkScope(ctx, tx, entity, id, scope); err != nil {
+				return err
 			}
-			return bytes
 		}
-	}
-
-	return v
-}
-
-func remapBody(md *ttlcache.Item[string, map[string]*sql.ColumnType], body api.UntypedDto) api.UntypedDto {
-	for key, val := range body {
-		if ct, ok := md.Value()[key]; ok {
-			body[key] = remapValue(val, ct)
+		if res, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+			return err
 		}
-	}
-	return body
+		at.add(audit.OpUpdate, id, before, res)
+		return nil
+	})
+	return res, err
 }
 
-func (be *impl) Create(ctx context.Context, entity string, body api.UntypedDto) (api.UntypedDto, error) {
+func (be *impl) Create(ctx context.Context, entity string, body api.UntypedDto) (res api.UntypedDto, err error) {
 	if !*be.config.Create {
 		return nil, errCreateNotAllowed
 	}
-	var (
-		err error
-		id  int64
-		res sql.Result
-	)
-	md := be.mdCache.Get(entity)
-	if md != nil {
-		body = remapBody(md, body)
+	if err = be.writable(entity); err != nil {
+		return nil, err
 	}
-	qry, values := createInsertQuery(entity, body)
-	be.l.Debug("SQL", "query", qry)
-	if res, err = be.config.DB().ExecContext(ctx, qry, values...); err != nil {
+	var scope *rowScope
+	if scope, err = be.scope(ctx, entity); err != nil {
 		return nil, err
 	}
-	// TODO: this could be configurable. There are scenarios where you don't use auto increment
-	if id, err = res.LastInsertId(); err != nil {
-		return nil, types.WrapError("failed to retrieve last insert ID", err)
+	if err = be.validate(ctx, entity, payloadCreate, false, body); err != nil {
+		return nil, err
 	}
-	return be.fetchOneItem(ctx, entity, strconv.FormatInt(id, 10), true)
+	body = be.toColumns(entity, body)
+	md := be.mdCache.Get(entity)
+	if md != nil {
+		body = be.tm.remapBody(md, body)
+	}
+	body = be.stamp(ctx, entity, scope.enforce(body), true)
+	at := be.newTrail(ctx, entity)
+	err = be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		var (
+			id int64
+			r  sql.Result
+		)
+		qry, values := createInsertQuery(be.table(entity), body)
+		be.l.Debug("SQL", "query", qry)
+		if r, err = tx.ExecContext(ctx, qry, values...); err != nil {
+			return err
+		}
+		// TODO: this could be configurable. There are scenarios where you don't use auto increment
+		if id, err = r.LastInsertId(); err != nil {
+			return types.WrapError("failed to retrieve last insert ID", err)
+		}
+		var key interface{} = id
+		if v := body[be.config.IdColumn(entity)]; v != nil && id == 0 {
+			// ID isn't generated by database
+			key = v
+		}
+		if err = be.checkScope(ctx, tx, entity, key, scope); err != nil {
+			return err
+		}
+		if res, err = be.fetchOne(ctx, tx, entity, key, true, scope.predicates()); err != nil {
+			return err
+		}
+		at.add(audit.OpCreate, key, nil, res)
+		return nil
+	})
+	return res, err
 }
 
 func (be *impl) MultiDelete(ctx context.Context, entity string, ids []interface{}) error {
 	if !*be.config.Delete {
 		return errDeleteNotAllowed
 	}
+	if err := be.writable(entity); err != nil {
+		return err
+	}
 	switch ic := len(ids); {
 	case ic == 0:
 		return errNoObj
 	case ic == 1:
 		return be.Delete(ctx, entity, fmt.Sprintf("%v", ids[0]))
 	default:
-		qry := createMultiDeleteQuery(entity, be.config.IdColumn(entity), ic)
-		be.l.Debug("SQL", "query", qry)
-		_, err := be.config.DB().ExecContext(ctx, qry, ids...)
-		return err
+		scope, err := be.scope(ctx, entity)
+		if err != nil {
+			return err
+		}
+		preds := append(scope.predicates(), be.liveness(entity, ExcludeDeleted)...)
+		idCol := be.config.IdColumn(entity)
+		args := append(ids, predicateArgs(preds)...)
+		at := be.newTrail(ctx, entity)
+		return be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+			var before []api.UntypedDto
+			if at != nil {
+				qry := createMultiSelectQuery(be.table(entity), idCol, ic, preds...)
+				be.l.Debug("SQL", "query", qry)
+				if before, err = be.fetchRows(ctx, tx, entity, qry, args...); err != nil {
+					return err
+				}
+			}
+			qry, delArgs := createMultiDeleteQuery(be.table(entity), idCol, ic, preds...), args
+			if sd := be.config.Entity(entity).SoftDelete; sd != nil {
+				qry = createMarkQuery(be.table(entity), sd.Column, createMultiItemFilter(idCol, ic, preds...))
+				delArgs = append([]interface{}{deletedMarker(sd, true)}, args...)
+			}
+			be.l.Debug("SQL", "query", qry)
+			if _, err = tx.ExecContext(ctx, qry, delArgs...); err != nil {
+				return err
+			}
+			for _, item := range before {
+				at.add(audit.OpDelete, item[be.config.IdField(entity)], item, nil)
+			}
+			return nil
+		})
 	}
 }
 
 func (be *impl) MultiUpdate(ctx context.Context, entity string, objs []api.UntypedDto) error {
 	var (
-		err error
-		tx  *sql.Tx
+		err   error
+		scope *rowScope
 	)
 	if !*be.config.Update {
 		return errUpdateNotAllowed
 	}
-	tx, err = be.config.DB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
-	if err != nil {
+	if err = be.writable(entity); err != nil {
 		return err
 	}
-
-	for _, obj := range objs {
-		idCol := be.config.IdColumn(entity)
-		id := obj[idCol].(string)
-		md := be.mdCache.Get(entity)
-		if md != nil {
-			obj = remapBody(md, obj)
-		}
-		qry, values := createUpdateQuery(entity, idCol, obj)
-		values = append(values, id)
-		be.l.Debug("SQL", "query", qry)
-		if _, err = tx.ExecContext(ctx, qry, values...); err != nil {
-			be.l.ErrorContext(ctx, "query execution failed, rolling back", "err", err)
-			return errors.Join(err, tx.Rollback())
-		}
+	if scope, err = be.scope(ctx, entity); err != nil {
+		return err
+	}
+	if err = be.validate(ctx, entity, payloadUpdate, true, objs...); err != nil {
+		return err
 	}
-	return tx.Commit()
+	preds := append(scope.predicates(), be.liveness(entity, ExcludeDeleted)...)
+	at := be.newTrail(ctx, entity)
+	return be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		for _, obj := range objs {
+			idCol := be.config.IdColumn(entity)
+			obj = be.toColumns(entity, obj)
+			id := fmt.Sprint(obj[idCol])
+			md := be.mdCache.Get(entity)
+			if md != nil {
+				obj = be.tm.remapBody(md, obj)
+			}
+			obj = be.stamp(ctx, entity, scope.enforce(obj), false)
+			var before, after api.UntypedDto
+			if at != nil || len(scope.predicates()) > 0 {
+				if before, err = be.fetchOne(ctx, tx, entity, id, at != nil, preds); err != nil {
+					return err
+				}
+			}
+			qry, values := createUpdateQuery(be.table(entity), idCol, obj, preds...)
+			values = append(values, id)
+			values = append(values, predicateArgs(preds)...)
+			be.l.Debug("SQL", "query", qry)
+			if _, err = tx.ExecContext(ctx, qry, values...); err != nil {
+				return err
+			}
+			if before != nil {
+				if err = be.checkScope(ctx, tx, entity, id, scope); err != nil {
+					return err
+				}
+			}
+			if at != nil {
+				if after, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+					return err
+				}
+				at.add(audit.OpUpdate, id, before, after)
+			}
+		}
+		return nil
+	})
 }
 
 func (be *impl) MultiCreate(ctx context.Context, entity string, replace bool, objs []api.UntypedDto) error {
 	var (
-		err error
-		tx  *sql.Tx
+		err   error
+		scope *rowScope
 	)
 	if !*be.config.Create {
 		return errCreateNotAllowed
 	}
-
-	tx, err = be.config.DB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
-	if err != nil {
+	if err = be.writable(entity); err != nil {
 		return err
 	}
-	for _, obj := range objs {
-		md := be.mdCache.Get(entity)
-		if md != nil {
-			obj = remapBody(md, obj)
-		}
-		var (
-			qry    string
-			values []interface{}
-		)
-		if replace {
-			qry, values = createReplaceQuery(entity, obj)
-		} else {
-			qry, values = createInsertQuery(entity, obj)
-		}
-
-		be.l.Debug("SQL", "query", qry)
-		if _, err = tx.ExecContext(ctx, qry, values...); err != nil {
-			be.l.ErrorContext(ctx, "query execution failed, rolling back", "err", err)
-			return errors.Join(types.WrapErrorWithStatus(
-				"query failed: "+qry, err, http.StatusInternalServerError),
-				tx.Rollback())
-		}
+	if scope, err = be.scope(ctx, entity); err != nil {
+		return err
 	}
+	if err = be.validate(ctx, entity, payloadCreate, true, objs...); err != nil {
+		return err
+	}
+	preds := scope.predicates()
+	idCol := be.config.IdColumn(entity)
+	at := be.newTrail(ctx, entity)
+
+	return be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		for _, obj := range objs {
+			obj = be.toColumns(entity, obj)
+			md := be.mdCache.Get(entity)
+			if md != nil {
+				obj = be.tm.remapBody(md, obj)
+			}
+			obj = be.stamp(ctx, entity, scope.enforce(obj), true)
+			var (
+				qry           string
+				values        []interface{}
+				r             sql.Result
+				before, after api.UntypedDto
+			)
+			if replace && at != nil && obj[idCol] != nil {
+				// REPLACE removes existing row regardless of row policy
+				if before, err = be.fetchOne(ctx, tx, entity, obj[idCol], true, nil); err != nil {
+					return err
+				}
+			}
+			if replace && len(preds) > 0 {
+				// REPLACE would remove conflicting row regardless of row policy,
+				// so delete only row visible to caller and then insert.
+				qry = createSingleDeleteQuery(be.table(entity), idCol, preds...)
+				be.l.Debug("SQL", "query", qry)
+				if _, err = tx.ExecContext(ctx, qry, append([]interface{}{obj[idCol]}, predicateArgs(preds)...)...); err != nil {
+					return err
+				}
+				qry, values = createInsertQuery(be.table(entity), obj)
+			} else if replace {
+				qry, values = createReplaceQuery(be.table(entity), obj)
+			} else {
+				qry, values = createInsertQuery(be.table(entity), obj)
+			}
 
-	return tx.Commit()
+			be.l.Debug("SQL", "query", qry)
+			if r, err = tx.ExecContext(ctx, qry, values...); err != nil {
+				return types.WrapErrorWithStatus("query failed: "+qry, err, http.StatusInternalServerError)
+			}
+			id := obj[idCol]
+			if id == nil {
+				if id, err = r.LastInsertId(); err != nil {
+					return types.WrapError("failed to retrieve last insert ID", err)
+				}
+			}
+			if err = be.checkScope(ctx, tx, entity, id, scope); err != nil {
+				return err
+			}
+			if at != nil {
+				if after, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+					return err
+				}
+				at.add(audit.OpCreate, id, before, after)
+			}
+		}
+		return nil
+	})
 }
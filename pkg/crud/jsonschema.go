@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/samber/lo"
+)
+
+var (
+	enumValueRE = regexp.MustCompile(`'((?:[^']|'')*)'`)
+
+	sqlType2JSONSchema = map[string]map[string]interface{}{
+		"TINYINT":   {"type": "integer"},
+		"SMALLINT":  {"type": "integer"},
+		"MEDIUMINT": {"type": "integer"},
+		"INT":       {"type": "integer"},
+		"INTEGER":   {"type": "integer"},
+		"BIGINT":    {"type": "integer", "format": "int64"},
+		"YEAR":      {"type": "integer"},
+		"BIT":       {"type": "integer"},
+		"FLOAT":     {"type": "number", "format": "float"},
+		"DOUBLE":    {"type": "number", "format": "double"},
+		"REAL":      {"type": "number", "format": "double"},
+		// rendered either as number or string (see decimal_as_string)
+		"DECIMAL":   {"type": []interface{}{"number", "string"}, "pattern": decimalRE.String()},
+		"NUMERIC":   {"type": []interface{}{"number", "string"}, "pattern": decimalRE.String()},
+		"DATE":      {"type": "string", "format": "date"},
+		"DATETIME":  {"type": "string", "format": "date-time"},
+		"TIMESTAMP": {"type": "string", "format": "date-time"},
+		// not a time of day, as it can exceed 24 hours
+		"TIME":       {"type": "string"},
+		"BINARY":     {"type": "string", "contentEncoding": "base64"},
+		"VARBINARY":  {"type": "string", "contentEncoding": "base64"},
+		"TINYBLOB":   {"type": "string", "contentEncoding": "base64"},
+		"BLOB":       {"type": "string", "contentEncoding": "base64"},
+		"MEDIUMBLOB": {"type": "string", "contentEncoding": "base64"},
+		"LONGBLOB":   {"type": "string", "contentEncoding": "base64"},
+		// any JSON value
+		"JSON": {},
+		// GeoJSON geometry
+		"GEOMETRY":           {"type": "object"},
+		"POINT":              {"type": "object"},
+		"LINESTRING":         {"type": "object"},
+		"POLYGON":            {"type": "object"},
+		"MULTIPOINT":         {"type": "object"},
+		"MULTILINESTRING":    {"type": "object"},
+		"MULTIPOLYGON":       {"type": "object"},
+		"GEOMETRYCOLLECTION": {"type": "object"},
+		"GEOMCOLLECTION":     {"type": "object"},
+	}
+)
+
+// enumValues parses values out of column type definition such as enum('a','b')
+func enumValues(columnType string) []interface{} {
+	var res []interface{}
+	for _, m := range enumValueRE.FindAllStringSubmatch(columnType, -1) {
+		res = append(res, strings.ReplaceAll(m[1], "''", "'"))
+	}
+	return res
+}
+
+// ColumnJSONSchema gets JSON schema (draft 2020-12) of column value, as it appears in API payloads.
+func ColumnJSONSchema(col *api.SchemaColumn) map[string]interface{} {
+	res := map[string]interface{}{"type": "string"}
+	if s, ok := sqlType2JSONSchema[col.Type]; ok {
+		res = make(map[string]interface{}, len(s)+2)
+		for k, v := range s {
+			res[k] = v
+		}
+	}
+	switch {
+	case col.Type == "ENUM":
+		res["enum"] = enumValues(col.ColumnType)
+	case col.Type == "SET":
+		res = map[string]interface{}{"type": "array", "uniqueItems": true,
+			"items": map[string]interface{}{"enum": enumValues(col.ColumnType)}}
+	case isFlagColumn(col):
+		// rendered as boolean, but numbers are still accepted
+		res["type"] = []interface{}{"boolean", "integer"}
+	case res["type"] == "string" && res["format"] == nil && res["contentEncoding"] == nil && col.MaxLength != nil:
+		res["maxLength"] = *col.MaxLength
+	case res["type"] == "integer" && strings.Contains(col.ColumnType, "unsigned"):
+		res["minimum"] = 0
+	}
+	if lo.FromPtr(col.ReadOnly) {
+		res["readOnly"] = true
+	}
+	if col.Nullable {
+		switch t := res["type"].(type) {
+		case string:
+			res["type"] = []interface{}{t, "null"}
+		case []interface{}:
+			res["type"] = append(slices.Clone(t), "null")
+		}
+		if e, ok := res["enum"]; ok {
+			res["enum"] = append(e.([]interface{}), nil)
+		}
+	}
+	return res
+}
+
+// EntityJSONSchema gets JSON schema (draft 2020-12) of entity payload. Properties other than columns are not allowed.
+// When required is set, columns that are neither nullable, nor have default value, nor are generated, nor are read-only
+// are required.
+func EntityJSONSchema(es *api.EntitySchema, required bool) map[string]interface{} {
+	props := make(map[string]interface{}, len(es.Columns))
+	var req []string
+	for i := range es.Columns {
+		col := &es.Columns[i]
+		props[col.Name] = ColumnJSONSchema(col)
+		if required && !col.Nullable && col.Default == nil && !col.AutoIncrement && !lo.FromPtr(col.ReadOnly) {
+			req = append(req, col.Name)
+		}
+	}
+	res := map[string]interface{}{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if len(req) > 0 {
+		res["required"] = req
+	}
+	return res
+}
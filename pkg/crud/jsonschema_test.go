@@ -0,0 +1,64 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnJSONSchema(t *testing.T) {
+	assert.Equal(t, []interface{}{"a", "it's", "c,d"}, enumValues("enum('a','it''s','c,d')"))
+	assert.Equal(t, map[string]interface{}{
+		"type": []interface{}{"string", "null"},
+		"enum": []interface{}{"S", "M", nil},
+	}, ColumnJSONSchema(&api.SchemaColumn{Type: "ENUM", ColumnType: "enum('S','M')", Nullable: true}))
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "date-time"},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "DATETIME", ColumnType: "datetime"}))
+	assert.Equal(t, map[string]interface{}{"type": "string", "contentEncoding": "base64"},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "BLOB", ColumnType: "blob", MaxLength: new(int64(65535))}))
+	assert.Equal(t, map[string]interface{}{},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "JSON", ColumnType: "json", Nullable: true}))
+	assert.Equal(t, map[string]interface{}{"type": "object"},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "POINT", ColumnType: "point"}))
+	assert.Equal(t, map[string]interface{}{"type": "string"},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "VECTOR", ColumnType: "vector(3)"}))
+	assert.Equal(t, map[string]interface{}{"type": []interface{}{"boolean", "integer", "null"}},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "TINYINT", ColumnType: "tinyint(1)", Nullable: true}))
+	assert.Equal(t, map[string]interface{}{"type": "array", "uniqueItems": true, "items": map[string]interface{}{"enum": []interface{}{"a", "b"}}},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "SET", ColumnType: "set('a','b')"}))
+	assert.Equal(t, []interface{}{"number", "string", "null"},
+		ColumnJSONSchema(&api.SchemaColumn{Type: "DECIMAL", ColumnType: "decimal(10,2)", Nullable: true})["type"])
+	assert.Equal(t, []interface{}{"number", "string"}, sqlType2JSONSchema["DECIMAL"]["type"])
+}
+
+func TestEntityJSONSchema(t *testing.T) {
+	es := &api.EntitySchema{Columns: []api.SchemaColumn{
+		{Name: "id", Type: "INT", ColumnType: "int", AutoIncrement: true},
+		{Name: "name", Type: "VARCHAR", ColumnType: "varchar(10)", MaxLength: new(int64(10))},
+		{Name: "created", Type: "TIMESTAMP", ColumnType: "timestamp", Default: new("CURRENT_TIMESTAMP")},
+		{Name: "created_by", Type: "VARCHAR", ColumnType: "varchar(64)", ReadOnly: new(true)},
+	}}
+	s := EntityJSONSchema(es, true)
+	assert.Equal(t, false, s["additionalProperties"])
+	assert.Equal(t, []string{"name"}, s["required"])
+	assert.Len(t, s["properties"], 4)
+	assert.Equal(t, true, s["properties"].(map[string]interface{})["created_by"].(map[string]interface{})["readOnly"])
+	assert.NotContains(t, EntityJSONSchema(es, false), "required")
+}
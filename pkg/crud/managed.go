@@ -0,0 +1,59 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+)
+
+// stamp fills managed columns of entity into body, which refers to database columns.
+// Values supplied by client are dropped first. Actor columns are left out when there is no authenticated principal.
+func (be *impl) stamp(ctx context.Context, entity string, body api.UntypedDto, create bool) api.UntypedDto {
+	ec := be.config.Entity(entity)
+	if ec == nil || ec.Managed == nil {
+		return body
+	}
+	for _, col := range ec.Managed.Columns() {
+		delete(body, col)
+	}
+	now := time.Now().UTC()
+	var actor *string
+	if p := auth.FromContext(ctx); p != nil {
+		actor = &p.Name
+	}
+	set := func(col *string, val interface{}) {
+		if col != nil {
+			body[*col] = val
+		}
+	}
+	mc := ec.Managed
+	set(mc.UpdatedAt, now)
+	if create {
+		set(mc.CreatedAt, now)
+	}
+	if actor != nil {
+		set(mc.UpdatedBy, *actor)
+		if create {
+			set(mc.CreatedBy, *actor)
+		}
+	}
+	return body
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStamp(t *testing.T) {
+	be := &impl{config: &types.BackendConfig{
+		Entities: map[string]*types.EntityConfig{
+			"emp": {Managed: &types.ManagedColumns{
+				CreatedAt: new("created_at"), UpdatedAt: new("updated_at"),
+				CreatedBy: new("created_by"), UpdatedBy: new("updated_by"),
+			}},
+		},
+	}}
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Name: "alice"})
+
+	body := be.stamp(ctx, "emp", api.UntypedDto{"name": "Bob", "created_by": "mallory", "created_at": "1970-01-01"}, true)
+	assert.Equal(t, "Bob", body["name"])
+	assert.Equal(t, "alice", body["created_by"])
+	assert.Equal(t, "alice", body["updated_by"])
+	assert.IsType(t, time.Time{}, body["created_at"])
+	assert.Equal(t, body["created_at"], body["updated_at"])
+
+	body = be.stamp(ctx, "emp", api.UntypedDto{"name": "Bob", "created_by": "mallory"}, false)
+	assert.NotContains(t, body, "created_by")
+	assert.NotContains(t, body, "created_at")
+	assert.Equal(t, "alice", body["updated_by"])
+	assert.Contains(t, body, "updated_at")
+
+	// anonymous
+	body = be.stamp(context.Background(), "emp", api.UntypedDto{"updated_by": "mallory"}, false)
+	assert.NotContains(t, body, "updated_by")
+	assert.Contains(t, body, "updated_at")
+
+	body = api.UntypedDto{"created_at": "x"}
+	assert.Equal(t, body, be.stamp(ctx, "dept", body, true))
+}
@@ -22,31 +22,10 @@ import (
 	"strings"
 
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/samber/lo"
 )
 
-func mapValue(ct *sql.ColumnType, val interface{}) interface{} {
-	switch ct.DatabaseTypeName() {
-	case "DATETIME", "TIMESTAMP":
-		x := &sql.NullTime{}
-		if err := x.Scan(val); err == nil {
-			return x.Time
-		}
-	case "DECIMAL":
-		x := &sql.NullFloat64{}
-		if err := x.Scan(val); err == nil {
-			return x.Float64
-		}
-	case "VARCHAR", "ENUM", "CHAR", "TEXT":
-		x := &sql.NullString{}
-		if err := x.Scan(val); err == nil {
-			return x.String
-		}
-	}
-	// TODO: more types?
-	return val
-}
-
 func getRowMetadata(rows *sql.Rows) ([]string, []*sql.ColumnType, error) {
 	var (
 		err      error
@@ -62,21 +41,6 @@ func getRowMetadata(rows *sql.Rows) ([]string, []*sql.ColumnType, error) {
 	return cols, colTypes, nil
 }
 
-func mapEntity(rows *sql.Rows, columns []string, columnTypes []*sql.ColumnType) (res api.UntypedDto, err error) {
-	values := make([]interface{}, len(columns))
-	for i := range values {
-		values[i] = new(interface{})
-	}
-	res = make(api.UntypedDto, len(values))
-	if err = rows.Scan(values...); err != nil {
-		return nil, err
-	}
-	for i, column := range columns {
-		res[column] = mapValue(columnTypes[i], *(values[i].(*interface{})))
-	}
-	return res, nil
-}
-
 func createReplaceQuery(entity string, body api.UntypedDto) (string, []interface{}) {
 	return createInsertOrReplaceQuery("REPLACE", entity, body)
 }
@@ -118,7 +82,9 @@ func createInsertOrReplaceQuery(verb, entity string, body api.UntypedDto) (strin
 	return sb.String(), values
 }
 
-func createUpdateQuery(entity, idColumn string, body api.UntypedDto) (string, []interface{}) {
+// createUpdateQuery generates `UPDATE <entity> SET <col> = ?,... WHERE <id> = ? [AND (<pred>)...] LIMIT 1` query.
+// Returned values only cover SET clause, caller is responsible to append ID and predicate arguments.
+func createUpdateQuery(entity, idColumn string, body api.UntypedDto, preds ...*predicate) (string, []interface{}) {
 	sb := strings.Builder{}
 	sb.WriteString("UPDATE `")
 	sb.WriteString(entity)
@@ -138,7 +104,7 @@ func createUpdateQuery(entity, idColumn string, body api.UntypedDto) (string, []
 		}
 	}
 	sb.WriteRune(' ')
-	sb.WriteString(createSingleItemFilter(idColumn))
+	sb.WriteString(createSingleItemFilter(idColumn, preds...))
 	return sb.String(), values
 }
 
@@ -150,47 +116,116 @@ func createDeleteQueryPrefix(entity string) string {
 	return sb.String()
 }
 
-// createSingleDeleteQuery generates `DELETE FROM <entity> WHERE <id> = ? LIMIT 1` query
-func createSingleDeleteQuery(entity, idColumn string) string {
+// createSingleDeleteQuery generates `DELETE FROM <entity> WHERE <id> = ? [AND (<pred>)...] LIMIT 1` query
+func createSingleDeleteQuery(entity, idColumn string, preds ...*predicate) string {
 	sb := strings.Builder{}
 	sb.WriteString(createDeleteQueryPrefix(entity))
-	sb.WriteString(createSingleItemFilter(idColumn))
+	sb.WriteString(createSingleItemFilter(idColumn, preds...))
 	return sb.String()
 }
 
 // createMultiDeleteQuery generates `DELETE FROM <entity> WHERE <id> IN (?,?,?....?)` query.
 // idsCount should be > 1
-func createMultiDeleteQuery(entity, idColumn string, idsCount int) string {
+func createMultiDeleteQuery(entity, idColumn string, idsCount int, preds ...*predicate) string {
 	sb := strings.Builder{}
 	sb.WriteString(createDeleteQueryPrefix(entity))
-	sb.WriteString(createMultiItemFilter(idColumn, idsCount))
+	sb.WriteString(createMultiItemFilter(idColumn, idsCount, preds...))
 	return sb.String()
 }
 
-func createSingleSelectQuery(entity, idColumn string) string {
+func createSingleSelectQuery(entity, idColumn string, preds ...*predicate) string {
+	return createSingleSelectFromQuery("`"+entity+"`", idColumn, preds...)
+}
+
+// createSingleSelectFromQuery generates `SELECT * FROM <source> WHERE <id> = ? [AND (<pred>)...] LIMIT 1` query,
+// where source is either quoted table name or derived table.
+func createSingleSelectFromQuery(source, idColumn string, preds ...*predicate) string {
+	sb := strings.Builder{}
+	sb.WriteString("SELECT * FROM ")
+	sb.WriteString(source)
+	sb.WriteRune(' ')
+	sb.WriteString(createSingleItemFilter(idColumn, preds...))
+	return sb.String()
+}
+
+// createMultiSelectQuery generates `SELECT * FROM <entity> WHERE <id> IN (?,?,?....?)` query.
+// idsCount should be > 1
+func createMultiSelectQuery(entity, idColumn string, idsCount int, preds ...*predicate) string {
 	sb := strings.Builder{}
 	sb.WriteString("SELECT * FROM `")
 	sb.WriteString(entity)
 	sb.WriteString("` ")
-	sb.WriteString(createSingleItemFilter(idColumn))
+	sb.WriteString(createMultiItemFilter(idColumn, idsCount, preds...))
 	return sb.String()
 }
 
-func createSingleItemFilter(idColumn string) string {
+func createSingleItemFilter(idColumn string, preds ...*predicate) string {
 	sb := strings.Builder{}
 	sb.WriteString("WHERE ")
 	sb.WriteRune('`')
 	sb.WriteString(idColumn)
 	sb.WriteRune('`')
 	sb.WriteString(" = ?")
+	writePredicates(&sb, preds)
 	sb.WriteString(" LIMIT 1")
 	return sb.String()
 }
 
+// writePredicates appends ` AND (<pred>)` for every predicate
+func writePredicates(sb *strings.Builder, preds []*predicate) {
+	for _, p := range preds {
+		sb.WriteString(" AND (")
+		sb.WriteString(p.sql)
+		sb.WriteRune(')')
+	}
+}
+
+// predicateArgs collects arguments of all predicates, in order.
+func predicateArgs(preds []*predicate) []interface{} {
+	var args []interface{}
+	for _, p := range preds {
+		args = append(args, p.args...)
+	}
+	return args
+}
+
+// createWhereClause generates ` WHERE (<filter>) AND (<pred>)...` clause, or empty string when there is no condition.
+func createWhereClause(flt query.FilterExpression, preds ...*predicate) (string, []interface{}) {
+	conds := make([]string, 0, len(preds)+1)
+	if flt != nil {
+		conds = append(conds, flt.String())
+	}
+	for _, p := range preds {
+		conds = append(conds, p.sql)
+	}
+	switch len(conds) {
+	case 0:
+		return "", nil
+	case 1:
+		return " WHERE " + conds[0], predicateArgs(preds)
+	default:
+		return " WHERE (" + strings.Join(conds, ") AND (") + ")", predicateArgs(preds)
+	}
+}
+
+// createOrderAndLimit generates ` ORDER BY ... LIMIT ...` part of query
+func createOrderAndLimit(qe query.Interface) string {
+	var sb strings.Builder
+	if len(qe.Orders()) > 0 {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(qe.Orders().String())
+	}
+	if qe.Paging() != nil {
+		sb.WriteString(" LIMIT ")
+		sb.WriteString(qe.Paging().String())
+	}
+	return sb.String()
+}
+
 // createMultiItemFilter generates `WHERE <id> IN (?,?,...?)` filter.
 // caller must ensure that idsCount > 1, either by falling back to createSingleItemFilter for (idsCount==1) or
 // by reporting error.
-func createMultiItemFilter(idColumn string, idsCount int) string {
+func createMultiItemFilter(idColumn string, idsCount int, preds ...*predicate) string {
 	sb := strings.Builder{}
 	sb.WriteString("WHERE ")
 	sb.WriteRune('`')
@@ -199,5 +234,6 @@ func createMultiItemFilter(idColumn string, idsCount int) string {
 	sb.WriteString(" IN (")
 	sb.WriteString(strings.Repeat("?,", idsCount-1))
 	sb.WriteString("?)")
+	writePredicates(&sb, preds)
 	return sb.String()
 }
@@ -19,6 +19,7 @@ package crud
 import (
 	"testing"
 
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +55,38 @@ func TestCreateSingleSelectQuery(t *testing.T) {
 	sql := createSingleSelectQuery(testEnt, testId)
 	assert.Equal(t, "SELECT * FROM `myentity` WHERE `id` = ? LIMIT 1", sql)
 }
+
+func TestCreateQueriesWithPredicates(t *testing.T) {
+	pred := &predicate{sql: "tenant_id = ?", args: []interface{}{"acme"}}
+	assert.Equal(t, "SELECT * FROM `myentity` WHERE `id` = ? AND (tenant_id = ?) LIMIT 1",
+		createSingleSelectQuery(testEnt, testId, pred))
+	assert.Equal(t, "DELETE FROM `myentity` WHERE `id` IN (?,?,?) AND (tenant_id = ?)",
+		createMultiDeleteQuery(testEnt, testId, 3, pred))
+	sql, vals := createUpdateQuery(testEnt, "ent_id", testBody, pred)
+	assert.Equal(t, "UPDATE `myentity` SET `age` = ?, `name` = ? WHERE `ent_id` = ? AND (tenant_id = ?) LIMIT 1", sql)
+	assert.Len(t, vals, 2)
+	assert.Equal(t, []interface{}{"acme"}, predicateArgs([]*predicate{pred}))
+}
+
+func TestCreateWhereClause(t *testing.T) {
+	var (
+		where string
+		args  []interface{}
+	)
+	where, args = createWhereClause(nil)
+	assert.Equal(t, "", where)
+	assert.Nil(t, args)
+	where, _ = createWhereClause(query.SimpleExpr("age", query.OpGt, 30))
+	assert.Equal(t, " WHERE age > 30", where)
+	where, args = createWhereClause(query.SimpleExpr("age", query.OpGt, 30),
+		&predicate{sql: "tenant_id = ?", args: []interface{}{"acme"}})
+	assert.Equal(t, " WHERE (age > 30) AND (tenant_id = ?)", where)
+	assert.Equal(t, []interface{}{"acme"}, args)
+}
+
+func TestCreateOrderAndLimit(t *testing.T) {
+	assert.Equal(t, " ORDER BY `name` DESC LIMIT 10, 5", createOrderAndLimit(query.NewBuilder().
+		OrderBy("name", false).
+		Paging(10, 5).
+		Build()))
+}
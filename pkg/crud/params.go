@@ -0,0 +1,242 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+var (
+	decimalRE       = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+	dateTimeLayouts = []string{time.RFC3339, time.DateOnly}
+)
+
+// compiledQuery is SQL statement split into fragments and parameter references.
+type compiledQuery struct {
+	params map[string]*types.QueryParam
+	texts  []string
+	refs   []string
+}
+
+func compileQuery(sql string, params map[string]*types.QueryParam) *compiledQuery {
+	cq := &compiledQuery{params: params}
+	cq.texts, cq.refs = types.SplitNamedSQL(sql)
+	return cq
+}
+
+// coerce converts raw request value into value of given type, validating constraints of param.
+func coerce(typ, raw string, p *types.QueryParam) (interface{}, error) {
+	var (
+		v   interface{}
+		num *float64
+		err error
+	)
+	switch typ {
+	case types.ParamInt:
+		var i int64
+		if i, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, fmt.Errorf("'%s' is not an integer", raw)
+		}
+		v, num = i, new(float64(i))
+	case types.ParamDecimal:
+		if !decimalRE.MatchString(raw) {
+			return nil, fmt.Errorf("'%s' is not a decimal number", raw)
+		}
+		f, _ := strconv.ParseFloat(raw, 64)
+		// passed as string to retain precision
+		v, num = raw, &f
+	case types.ParamDate:
+		var t time.Time
+		for _, layout := range dateTimeLayouts {
+			if t, err = time.Parse(layout, raw); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a date", raw)
+		}
+		v = t
+	case types.ParamBool:
+		var b bool
+		if b, err = strconv.ParseBool(raw); err != nil {
+			return nil, fmt.Errorf("'%s' is not a boolean", raw)
+		}
+		v = b
+	default:
+		if p.Regexp() != nil && !p.Regexp().MatchString(raw) {
+			return nil, fmt.Errorf("'%s' does not match pattern %s", raw, *p.Pattern)
+		}
+		v, num = raw, new(float64(len([]rune(raw))))
+	}
+	if len(p.Enum) > 0 && !slices.Contains(p.Enum, raw) {
+		return nil, fmt.Errorf("'%s' is not one of %s", raw, strings.Join(p.Enum, ", "))
+	}
+	if p.Type != types.ParamList && num != nil {
+		if err = checkBounds(*num, p); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func checkBounds(n float64, p *types.QueryParam) error {
+	what := "value"
+	switch p.Type {
+	case types.ParamString:
+		what = "length"
+	case types.ParamList:
+		what = "number of items"
+	}
+	if p.Min != nil && n < *p.Min {
+		return fmt.Errorf("%s must be at least %v", what, *p.Min)
+	}
+	if p.Max != nil && n > *p.Max {
+		return fmt.Errorf("%s must be at most %v", what, *p.Max)
+	}
+	return nil
+}
+
+// bindParam turns raw values of single parameter into list of SQL arguments.
+// Only list parameter can produce more than one argument.
+func bindParam(p *types.QueryParam, raw []string, present bool) ([]interface{}, error) {
+	if !present && p.Default != nil {
+		raw, present = []string{*p.Default}, true
+	}
+	if !present {
+		if *p.Required {
+			return nil, fmt.Errorf("required")
+		}
+		return []interface{}{nil}, nil
+	}
+	if p.Type != types.ParamList {
+		if len(raw) != 1 {
+			return nil, fmt.Errorf("expected single value, got %d", len(raw))
+		}
+		v, err := coerce(p.Type, raw[0], p)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{v}, nil
+	}
+	var items []interface{}
+	for _, r := range raw {
+		for _, item := range strings.Split(r, ",") {
+			v, err := coerce(*p.ItemType, strings.TrimSpace(item), p)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+	}
+	if err := checkBounds(float64(len(items)), p); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// bindNull binds explicit null, which is accepted only by optional parameter. Default value doesn't apply.
+func bindNull(p *types.QueryParam) ([]interface{}, error) {
+	if *p.Required {
+		return nil, fmt.Errorf("required, can't be null")
+	}
+	return []interface{}{nil}, nil
+}
+
+// bindValues validates named arguments against declared parameters and coerces them.
+// Argument with nil value is explicit null.
+// All problems are reported at once as 400 error.
+func bindValues(params map[string]*types.QueryParam, args []interface{}) (map[string][]interface{}, error) {
+	values := map[string][]string{}
+	nulls := map[string]bool{}
+	var problems []string
+	for _, arg := range args {
+		na, ok := arg.(sql.NamedArg)
+		if !ok {
+			return nil, types.NewErrorWithStatus("only named parameters are accepted", http.StatusBadRequest)
+		}
+		if _, ok = params[na.Name]; !ok {
+			problems = append(problems, fmt.Sprintf("p.%s: unknown parameter", na.Name))
+			continue
+		}
+		switch v := na.Value.(type) {
+		case nil:
+			nulls[na.Name] = true
+		case []string:
+			values[na.Name] = append(values[na.Name], v...)
+		default:
+			values[na.Name] = append(values[na.Name], fmt.Sprintf("%v", v))
+		}
+	}
+	bound := map[string][]interface{}{}
+	for name, p := range params {
+		raw, present := values[name]
+		var (
+			b   []interface{}
+			err error
+		)
+		if nulls[name] && !present {
+			b, err = bindNull(p)
+		} else {
+			b, err = bindParam(p, raw, present)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("p.%s: %v", name, err))
+			continue
+		}
+		bound[name] = b
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, types.NewErrorWithStatus("invalid parameters: "+strings.Join(problems, "; "), http.StatusBadRequest)
+	}
+	return bound, nil
+}
+
+// render produces SQL with positional placeholders along with arguments, list parameters are expanded.
+func (cq *compiledQuery) render(bound map[string][]interface{}) (string, []interface{}) {
+	var (
+		sb     strings.Builder
+		result []interface{}
+	)
+	for i, ref := range cq.refs {
+		sb.WriteString(cq.texts[i])
+		sb.WriteString(strings.TrimSuffix(strings.Repeat("?,", len(bound[ref])), ","))
+		result = append(result, bound[ref]...)
+	}
+	sb.WriteString(cq.texts[len(cq.texts)-1])
+	return sb.String(), result
+}
+
+// bind validates named arguments and renders query.
+func (cq *compiledQuery) bind(args []interface{}) (string, []interface{}, error) {
+	bound, err := bindValues(cq.params, args)
+	if err != nil {
+		return "", nil, err
+	}
+	qry, res := cq.render(bound)
+	return qry, res, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNamedQuery(t *testing.T) *compiledQuery {
+	cfg := &types.Config{
+		Backends: types.Backends{"demo": {
+			Queries: map[string]*types.NamedQuery{
+				"q": {
+					SQL: "SELECT * FROM emp WHERE dept IN (:depts) AND salary >= :min_salary AND hired > :since" +
+						" AND note <> ':not_a_param' AND name LIKE :name",
+					Params: map[string]*types.QueryParam{
+						"depts":      {Type: types.ParamList, ItemType: new(types.ParamInt), Min: new(1.0), Max: new(3.0)},
+						"min_salary": {Type: types.ParamDecimal, Default: new("0"), Min: new(0.0)},
+						"since":      {Type: types.ParamDate, Required: new(true)},
+						"name":       {Type: types.ParamString, Pattern: new(`^[A-Z]`), Max: new(5.0)},
+					},
+				},
+			},
+		}},
+	}
+	assert.NoError(t, cfg.CheckAndNormalize())
+	nq := cfg.Backends["demo"].Queries["q"]
+	return compileQuery(nq.SQL, nq.Params)
+}
+
+func TestBindParams(t *testing.T) {
+	cq := testNamedQuery(t)
+	assert.Equal(t, []string{"depts", "min_salary", "since", "name"}, cq.refs)
+
+	qry, args, err := cq.bind([]interface{}{
+		sql.Named("depts", []string{"1,2", "3"}),
+		sql.Named("since", []string{"2024-01-31"}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM emp WHERE dept IN (?,?,?) AND salary >= ? AND hired > ?"+
+		" AND note <> ':not_a_param' AND name LIKE ?", qry)
+	assert.Equal(t, []interface{}{int64(1), int64(2), int64(3), "0",
+		time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), nil}, args)
+
+	_, _, err = cq.bind([]interface{}{
+		sql.Named("depts", []string{"1,x"}),
+		sql.Named("min_salary", []string{"-1"}),
+		sql.Named("name", []string{"joe"}),
+		sql.Named("bogus", []string{"1"}),
+	})
+	var ews *types.ErrorWithStatus
+	assert.True(t, errors.As(err, &ews))
+	assert.Equal(t, http.StatusBadRequest, ews.Status)
+	assert.Equal(t, "invalid parameters: p.bogus: unknown parameter; "+
+		"p.depts: 'x' is not an integer; "+
+		"p.min_salary: value must be at least 0; "+
+		"p.name: 'joe' does not match pattern ^[A-Z]; "+
+		"p.since: required", ews.Msg)
+
+	_, _, err = cq.bind([]interface{}{
+		sql.Named("depts", []string{"1,2,3,4"}),
+		sql.Named("since", []string{"2024-01-31"}),
+	})
+	assert.ErrorContains(t, err, "p.depts: number of items must be at most 3")
+
+	_, _, err = cq.bind([]interface{}{"positional"})
+	assert.Error(t, err)
+}
+
+func TestUndeclaredParam(t *testing.T) {
+	cfg := &types.Config{
+		Backends: types.Backends{"demo": {
+			Queries: map[string]*types.NamedQuery{
+				"q": {
+					SQL:    "SELECT * FROM emp WHERE id = :id AND dept = :dept",
+					Params: map[string]*types.QueryParam{"id": {Type: types.ParamInt}},
+				},
+			},
+		}},
+	}
+	assert.ErrorContains(t, cfg.CheckAndNormalize(), "undeclared parameter: dept")
+}
+
+func TestCompileCommand(t *testing.T) {
+	cfg := &types.Config{
+		Backends: types.Backends{"demo": {
+			Commands: map[string]*types.Command{
+				"archive": {
+					Statements: []string{
+						"INSERT INTO emp_archive SELECT * FROM emp WHERE id = :id",
+						"DELETE FROM emp WHERE id = :id",
+						"CALL recalc(:id, @total)",
+					},
+					Params: map[string]*types.QueryParam{"id": {Type: types.ParamInt, Required: new(true)}},
+					Out:    []string{"total"},
+				},
+			},
+		}},
+	}
+	assert.NoError(t, cfg.CheckAndNormalize())
+	cmd := cfg.Backends["demo"].Commands["archive"]
+	bound, err := bindValues(cmd.Params, []interface{}{sql.Named("id", []string{"7"})})
+	assert.NoError(t, err)
+	stmts := compileCommand(cmd)
+	assert.Len(t, stmts, 3)
+	qry, args := stmts[2].render(bound)
+	assert.Equal(t, "CALL recalc(?, @total)", qry)
+	assert.Equal(t, []interface{}{int64(7)}, args)
+	assert.True(t, queryStmtRE.MatchString(qry))
+	assert.False(t, queryStmtRE.MatchString(stmts[1].texts[0]))
+
+	// explicit null overrides default, but it's not accepted by required parameter
+	cmd.Params["note"] = &types.QueryParam{Type: types.ParamString, Default: new("n/a")}
+	assert.NoError(t, cfg.CheckAndNormalize())
+	bound, err = bindValues(cmd.Params, []interface{}{sql.Named("id", []string{"7"}), sql.Named("note", nil)})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{nil}, bound["note"])
+	_, err = bindValues(cmd.Params, []interface{}{sql.Named("id", nil)})
+	assert.ErrorContains(t, err, "p.id: required, can't be null")
+	_, err = bindValues(cmd.Params, []interface{}{sql.Named("id", []string{"7"}), sql.Named("bogus", nil)})
+	assert.ErrorContains(t, err, "p.bogus: unknown parameter")
+
+	cmd.Out = []string{"total; DROP TABLE emp"}
+	assert.Error(t, cfg.CheckAndNormalize())
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+const principalRef = "principal"
+
+var (
+	claimRefRE = regexp.MustCompile(`\$\{(principal|claims\.[\w.-]+)}`)
+	// top-level conjunct in form of "<column> = ${...}"
+	forcedColRE = regexp.MustCompile("^\\(?\\s*`?(\\w+)`?\\s*=\\s*\\$\\{(principal|claims\\.[\\w.-]+)}\\s*\\)?$")
+	andRE       = regexp.MustCompile(`(?i)\s+AND\s+`)
+	orRE        = regexp.MustCompile(`(?i)\bOR\b`)
+)
+
+// predicate is SQL boolean expression along with values of its placeholders.
+type predicate struct {
+	sql  string
+	args []interface{}
+}
+
+// rowPolicy is compiled form of types.EntityConfig.RowPolicy
+type rowPolicy struct {
+	// SQL with references replaced by placeholders
+	sql string
+	// reference for each placeholder, in order of appearance
+	refs []string
+	// mapping from column name to reference that it's bound to by equality
+	forced map[string]string
+}
+
+// rowScope is row policy bound to concrete principal.
+type rowScope struct {
+	pred   *predicate
+	forced map[string]interface{}
+}
+
+func compileRowPolicy(expr string) *rowPolicy {
+	rp := &rowPolicy{forced: map[string]string{}}
+	rp.sql = claimRefRE.ReplaceAllStringFunc(expr, func(ref string) string {
+		rp.refs = append(rp.refs, claimRefRE.FindStringSubmatch(ref)[1])
+		return "?"
+	})
+	if !orRE.MatchString(expr) {
+		for _, part := range andRE.Split(strings.TrimSpace(expr), -1) {
+			if m := forcedColRE.FindStringSubmatch(part); m != nil {
+				rp.forced[m[1]] = m[2]
+			}
+		}
+	}
+	return rp
+}
+
+func resolveRef(p *auth.Principal, ref string) (interface{}, error) {
+	if ref == principalRef {
+		return p.Name, nil
+	}
+	var cur interface{} = p.Claims
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "claims."), ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			cur = nil
+			break
+		}
+		cur = m[part]
+	}
+	if cur == nil {
+		return nil, types.NewErrorWithStatus(fmt.Sprintf("principal '%s' lacks %s required by row policy", p.Name, ref),
+			http.StatusForbidden)
+	}
+	return cur, nil
+}
+
+// bind resolves all references against given principal.
+func (rp *rowPolicy) bind(p *auth.Principal) (*rowScope, error) {
+	if p == nil {
+		return nil, types.NewErrorWithStatus("row policy requires authenticated principal", http.StatusForbidden)
+	}
+	rs := &rowScope{
+		pred:   &predicate{sql: rp.sql},
+		forced: make(map[string]interface{}, len(rp.forced)),
+	}
+	values := map[string]interface{}{}
+	for _, ref := range rp.refs {
+		v, err := resolveRef(p, ref)
+		if err != nil {
+			return nil, err
+		}
+		values[ref] = v
+		rs.pred.args = append(rs.pred.args, v)
+	}
+	for col, ref := range rp.forced {
+		rs.forced[col] = values[ref]
+	}
+	return rs, nil
+}
+
+// predicates returns predicates of scope, if any.
+func (rs *rowScope) predicates() []*predicate {
+	if rs == nil || rs.pred == nil {
+		return nil
+	}
+	return []*predicate{rs.pred}
+}
+
+// enforce overwrites forced columns in payload with values bound to principal.
+func (rs *rowScope) enforce(body api.UntypedDto) api.UntypedDto {
+	if rs == nil {
+		return body
+	}
+	for col, val := range rs.forced {
+		body[col] = val
+	}
+	return body
+}
+
+// checkScope ensures that item remains visible under row scope after it was written, so that caller can't create item
+// outside of their scope or move item out of it. Error rolls back transaction.
+func (be *impl) checkScope(ctx context.Context, q querier, entity string, id interface{}, scope *rowScope) error {
+	preds := scope.predicates()
+	if len(preds) == 0 {
+		return nil
+	}
+	item, err := be.fetchOne(ctx, q, entity, id, false, preds)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return errOutOfScope
+	}
+	return nil
+}
+
+func (be *impl) Visible(ctx context.Context, entity string, item api.UntypedDto) (bool, error) {
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return false, err
+	}
+	preds := scope.predicates()
+	if len(preds) == 0 {
+		return true, nil
+	}
+	if item == nil {
+		return false, nil
+	}
+	// policy is evaluated against single-row derived table made of item, named as table, so that qualified references work
+	item = be.toColumns(entity, item)
+	cols := slices.Sorted(maps.Keys(item))
+	sel := make([]string, 0, len(cols))
+	args := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		sel = append(sel, fmt.Sprintf("? AS `%s`", col))
+		v := item[col]
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			if v, err = json.Marshal(v); err != nil {
+				return false, err
+			}
+		}
+		args = append(args, v)
+	}
+	qry := fmt.Sprintf("SELECT 1 FROM (SELECT %s) AS `%s` WHERE %s",
+		strings.Join(sel, ", "), be.table(entity), preds[0].sql)
+	be.l.Debug("SQL", "query", qry)
+	ctx, done := be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	rows, err := be.config.DB().QueryContext(ctx, qry, append(args, predicateArgs(preds)...)...)
+	if err != nil {
+		return false, done(types.WrapError("failed to evaluate row policy", err))
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	visible := rows.Next()
+	return visible, done(rows.Err())
+}
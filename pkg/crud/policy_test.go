@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowPolicy(t *testing.T) {
+	var (
+		rs  *rowScope
+		err error
+	)
+	rp := compileRowPolicy("tenant_id = ${claims.tenant} AND `owner` = ${principal} AND deleted = 0")
+	assert.Equal(t, "tenant_id = ? AND `owner` = ? AND deleted = 0", rp.sql)
+	assert.Equal(t, []string{"claims.tenant", "principal"}, rp.refs)
+	assert.Equal(t, map[string]string{"tenant_id": "claims.tenant", "owner": "principal"}, rp.forced)
+
+	rs, err = rp.bind(&auth.Principal{Name: "alice", Claims: map[string]interface{}{"tenant": "acme"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"acme", "alice"}, rs.pred.args)
+	body := rs.enforce(api.UntypedDto{"tenant_id": "evil", "name": "x"})
+	assert.Equal(t, "acme", body["tenant_id"])
+	assert.Equal(t, "alice", body["owner"])
+	assert.Equal(t, "x", body["name"])
+
+	t.Run("missing claim", func(t *testing.T) {
+		_, err = rp.bind(&auth.Principal{Name: "bob"})
+		assert.Error(t, err)
+	})
+	t.Run("anonymous", func(t *testing.T) {
+		_, err = rp.bind(nil)
+		assert.Error(t, err)
+	})
+	t.Run("nested claim", func(t *testing.T) {
+		rs, err = compileRowPolicy("org_id = ${claims.org.id}").bind(&auth.Principal{
+			Name:   "carol",
+			Claims: map[string]interface{}{"org": map[string]interface{}{"id": "o1"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"o1"}, rs.pred.args)
+		assert.Equal(t, map[string]interface{}{"org_id": "o1"}, rs.forced)
+	})
+	t.Run("disjunction is not forced", func(t *testing.T) {
+		assert.Empty(t, compileRowPolicy("tenant_id = ${claims.tenant} OR public = 1").forced)
+	})
+	t.Run("nil scope", func(t *testing.T) {
+		var none *rowScope
+		assert.Nil(t, none.predicates())
+		assert.Equal(t, api.UntypedDto{"a": 1}, none.enforce(api.UntypedDto{"a": 1}))
+	})
+}
+
+func TestVisible(t *testing.T) {
+	be := &impl{
+		config:      &types.BackendConfig{},
+		entPolicies: map[string]*rowPolicy{"emp": compileRowPolicy("owner = ${principal}")},
+	}
+	ok, err := be.Visible(context.TODO(), "dept", api.UntypedDto{"id": 1})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	_, err = be.Visible(context.TODO(), "emp", nil)
+	assert.Error(t, err)
+	ok, err = be.Visible(auth.NewContext(context.TODO(), &auth.Principal{Name: "alice"}), "emp", nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = be.Visible(context.TODO(), "dept", nil)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+const (
+	schemaColumnsQuery = "SELECT COLUMN_NAME, DATA_TYPE, COLUMN_TYPE, IS_NULLABLE, CHARACTER_MAXIMUM_LENGTH, " +
+		"NUMERIC_PRECISION, NUMERIC_SCALE, COLUMN_DEFAULT, EXTRA FROM information_schema.COLUMNS " +
+		"WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION"
+	schemaKeysQuery = "SELECT tc.CONSTRAINT_NAME, tc.CONSTRAINT_TYPE, kcu.COLUMN_NAME, " +
+		"kcu.REFERENCED_TABLE_NAME, kcu.REFERENCED_COLUMN_NAME " +
+		"FROM information_schema.TABLE_CONSTRAINTS tc JOIN information_schema.KEY_COLUMN_USAGE kcu " +
+		"ON tc.CONSTRAINT_SCHEMA = kcu.CONSTRAINT_SCHEMA AND tc.TABLE_NAME = kcu.TABLE_NAME " +
+		"AND tc.CONSTRAINT_NAME = kcu.CONSTRAINT_NAME " +
+		"WHERE tc.TABLE_SCHEMA = DATABASE() AND tc.TABLE_NAME = ? " +
+		"AND tc.CONSTRAINT_TYPE IN ('PRIMARY KEY', 'UNIQUE', 'FOREIGN KEY') " +
+		"ORDER BY tc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION"
+)
+
+// keyColumn is single row of schemaKeysQuery
+type keyColumn struct {
+	name      string
+	typ       string
+	column    string
+	refEntity sql.NullString
+	refColumn sql.NullString
+}
+
+// addKeys fills keys of schema from rows of schemaKeysQuery. Rows of same constraint must be adjacent.
+func addKeys(schema *api.EntitySchema, keys []keyColumn) {
+	for i, k := range keys {
+		first := i == 0 || keys[i-1].name != k.name
+		switch k.typ {
+		case "PRIMARY KEY":
+			schema.PrimaryKey = append(schema.PrimaryKey, k.column)
+		case "UNIQUE":
+			if first {
+				schema.UniqueKeys = append(schema.UniqueKeys, api.UniqueKey{Name: k.name})
+			}
+			uk := &schema.UniqueKeys[len(schema.UniqueKeys)-1]
+			uk.Columns = append(uk.Columns, k.column)
+		case "FOREIGN KEY":
+			if first {
+				schema.ForeignKeys = append(schema.ForeignKeys, api.ForeignKey{Name: k.name, RefEntity: k.refEntity.String})
+			}
+			fk := &schema.ForeignKeys[len(schema.ForeignKeys)-1]
+			fk.Columns = append(fk.Columns, k.column)
+			fk.RefColumns = append(fk.RefColumns, k.refColumn.String)
+		}
+	}
+}
+
+func scanSchemaColumn(rows *sql.Rows) (*api.SchemaColumn, error) {
+	var (
+		col                 api.SchemaColumn
+		nullable, extra     string
+		maxLen, prec, scale sql.NullInt64
+		def                 sql.NullString
+	)
+	if err := rows.Scan(&col.Name, &col.Type, &col.ColumnType, &nullable, &maxLen, &prec, &scale, &def, &extra); err != nil {
+		return nil, err
+	}
+	col.Type = strings.ToUpper(col.Type)
+	col.Nullable = nullable == "YES"
+	col.AutoIncrement = strings.Contains(strings.ToLower(extra), "auto_increment")
+	if maxLen.Valid {
+		col.MaxLength = &maxLen.Int64
+	}
+	if prec.Valid {
+		col.Precision = new(int(prec.Int64))
+	}
+	if scale.Valid {
+		col.Scale = new(int(scale.Int64))
+	}
+	if def.Valid {
+		col.Default = &def.String
+	}
+	return &col, nil
+}
+
+func (be *impl) DescribeEntity(ctx context.Context, entity string) (_ *api.EntitySchema, err error) {
+	if !*be.config.Read {
+		return nil, errReadNotAllowed
+	}
+	var done func(error) error
+	ctx, done = be.deadline(ctx, kindRead, be.config.QueryTimeout)
+	defer func() {
+		err = done(err)
+	}()
+	es, err := be.entitySchema(ctx, be.config.DB(), entity)
+	if err != nil {
+		return nil, err
+	}
+	return be.apiSchema(es), nil
+}
+
+// entitySchema gets schema of entity, which is cached for some time.
+func (be *impl) entitySchema(ctx context.Context, q querier, entity string) (*api.EntitySchema, error) {
+	if item := be.entSchemas.Get(entity); item != nil {
+		return item.Value(), nil
+	}
+	es, err := be.describeEntity(ctx, q, entity)
+	if err != nil {
+		return nil, err
+	}
+	be.entSchemas.Set(entity, es, ttlcache.DefaultTTL)
+	return es, nil
+}
+
+// flagColumns gets columns of entity that hold flags (TINYINT(1) and BIT(1)), these are rendered as booleans.
+// When entity can't be described, no column is treated as flag.
+func (be *impl) flagColumns(ctx context.Context, q querier, entity string) map[string]bool {
+	es, err := be.entitySchema(ctx, q, entity)
+	if err != nil {
+		be.l.Warn("unable to describe entity", "entity", entity, "error", err)
+		return nil
+	}
+	res := map[string]bool{}
+	for _, col := range es.Columns {
+		if isFlagColumn(&col) {
+			res[col.Name] = true
+		}
+	}
+	return res
+}
+
+func isFlagColumn(col *api.SchemaColumn) bool {
+	return (col.Type == "TINYINT" || col.Type == "BIT") && strings.HasPrefix(col.ColumnType, strings.ToLower(col.Type)+"(1)")
+}
+
+// describeEntity reads schema of entity from information_schema.
+func (be *impl) describeEntity(ctx context.Context, q querier, entity string) (*api.EntitySchema, error) {
+	if be.config.Entity(entity).Virtual() {
+		return be.describeVirtual(ctx, q, entity)
+	}
+	schema := &api.EntitySchema{
+		Name:        entity,
+		IdColumn:    be.config.IdColumn(entity),
+		Columns:     []api.SchemaColumn{},
+		PrimaryKey:  []string{},
+		UniqueKeys:  []api.UniqueKey{},
+		ForeignKeys: []api.ForeignKey{},
+	}
+	be.l.Debug("SQL", "query", schemaColumnsQuery)
+	rows, err := q.QueryContext(ctx, schemaColumnsQuery, be.table(entity))
+	if err != nil {
+		return nil, types.WrapError("failed to fetch columns of entity "+entity, err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	for rows.Next() {
+		var col *api.SchemaColumn
+		if col, err = scanSchemaColumn(rows); err != nil {
+			return nil, types.WrapError("failed to fetch columns of entity "+entity, err)
+		}
+		schema.Columns = append(schema.Columns, *col)
+	}
+	if len(schema.Columns) == 0 {
+		return nil, types.NewErrorWithStatus("no such entity: "+entity, http.StatusNotFound)
+	}
+
+	be.l.Debug("SQL", "query", schemaKeysQuery)
+	keyRows, err := q.QueryContext(ctx, schemaKeysQuery, be.table(entity))
+	if err != nil {
+		return nil, types.WrapError("failed to fetch keys of entity "+entity, err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(keyRows)
+	var keys []keyColumn
+	for keyRows.Next() {
+		var k keyColumn
+		if err = keyRows.Scan(&k.name, &k.typ, &k.column, &k.refEntity, &k.refColumn); err != nil {
+			return nil, types.WrapError("failed to fetch keys of entity "+entity, err)
+		}
+		keys = append(keys, k)
+	}
+	addKeys(schema, keys)
+	return schema, nil
+}
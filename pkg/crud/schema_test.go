@@ -0,0 +1,51 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddKeys(t *testing.T) {
+	ref := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	schema := &api.EntitySchema{}
+	addKeys(schema, []keyColumn{
+		{name: "PRIMARY", typ: "PRIMARY KEY", column: "id"},
+		{name: "fk_emp_dept", typ: "FOREIGN KEY", column: "dept_id", refEntity: ref("dept"), refColumn: ref("id")},
+		{name: "fk_emp_dept", typ: "FOREIGN KEY", column: "dept_site", refEntity: ref("dept"), refColumn: ref("site")},
+		{name: "uq_email", typ: "UNIQUE", column: "email"},
+		{name: "uq_name", typ: "UNIQUE", column: "first_name"},
+		{name: "uq_name", typ: "UNIQUE", column: "last_name"},
+	})
+	assert.Equal(t, []string{"id"}, schema.PrimaryKey)
+	assert.Equal(t, []api.UniqueKey{
+		{Name: "uq_email", Columns: []string{"email"}},
+		{Name: "uq_name", Columns: []string{"first_name", "last_name"}},
+	}, schema.UniqueKeys)
+	assert.Equal(t, []api.ForeignKey{{
+		Name:       "fk_emp_dept",
+		Columns:    []string{"dept_id", "dept_site"},
+		RefEntity:  "dept",
+		RefColumns: []string{"id", "site"},
+	}}, schema.ForeignKeys)
+}
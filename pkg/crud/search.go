@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// fullText is full-text search compiled into SQL.
+type fullText struct {
+	// condition that matching items satisfy
+	cond *predicate
+	// expression that computes relevance of item
+	score *predicate
+}
+
+// fullText compiles search into SQL, it returns nil when there is no search.
+func (be *impl) fullText(entity string, s *query.Search) (*fullText, error) {
+	if s == nil {
+		return nil, nil
+	}
+	ec := be.config.Entity(entity)
+	if ec == nil || ec.Search == nil {
+		return nil, types.NewErrorWithStatus(fmt.Sprintf("entity %s does not support full-text search", entity), http.StatusBadRequest)
+	}
+	mode := s.Mode
+	if mode == "" {
+		mode = query.SearchMode(*ec.Search.Mode)
+	}
+	modifier := "NATURAL LANGUAGE"
+	if mode == query.SearchBoolean {
+		modifier = "BOOLEAN"
+	}
+	// columns were validated along with configuration
+	match := fmt.Sprintf("MATCH(%s) AGAINST (? IN %s MODE)",
+		"`"+strings.Join(ec.Search.Columns, "`, `")+"`", modifier)
+	return &fullText{
+		cond:  &predicate{sql: match, args: []interface{}{s.Text}},
+		score: &predicate{sql: match, args: []interface{}{s.Text}},
+	}, nil
+}
+
+// orderedByScore checks whether items are ordered by relevance.
+func orderedByScore(qe query.Interface) bool {
+	return slices.ContainsFunc(qe.Orders(), func(o query.Order) bool {
+		return o.Name() == query.ScoreColumn
+	})
+}
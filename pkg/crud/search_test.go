@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullText(t *testing.T) {
+	be := &impl{config: &types.BackendConfig{
+		Driver: new(types.DefaultDbDriver),
+		Entities: map[string]*types.EntityConfig{
+			"product": {Search: &types.SearchConfig{Columns: []string{"name", "description"}}},
+		},
+	}}
+	assert.NoError(t, (&types.Config{Backends: types.Backends{"demo": be.config}}).CheckAndNormalize())
+	assert.ErrorContains(t, (&types.Config{Backends: types.Backends{"demo": {Entities: map[string]*types.EntityConfig{
+		"broken": {Search: &types.SearchConfig{Columns: []string{"name`)"}}},
+	}}}}).CheckAndNormalize(), "invalid search column")
+	ft, err := be.fullText("product", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, ft)
+
+	ft, err = be.fullText("product", &query.Search{Text: "red shoes"})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH(`name`, `description`) AGAINST (? IN NATURAL LANGUAGE MODE)", ft.cond.sql)
+	assert.Equal(t, []interface{}{"red shoes"}, ft.cond.args)
+	assert.Equal(t, ft.cond.sql, ft.score.sql)
+
+	ft, err = be.fullText("product", &query.Search{Text: "+red -blue", Mode: query.SearchBoolean})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH(`name`, `description`) AGAINST (? IN BOOLEAN MODE)", ft.cond.sql)
+
+	_, err = be.fullText("order", &query.Search{Text: "x"})
+	assert.Equal(t, http.StatusBadRequest, err.(*types.ErrorWithStatus).Status)
+}
+
+func TestOrderedByScore(t *testing.T) {
+	assert.False(t, orderedByScore(query.NewBuilder().OrderBy("name", true).Build()))
+	assert.True(t, orderedByScore(query.NewBuilder().OrderBy(query.ScoreColumn, false).Build()))
+}
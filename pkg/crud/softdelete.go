@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// Deleted tells which items of entities with soft delete are visible.
+type Deleted int
+
+const (
+	// ExcludeDeleted hides soft-deleted items, this is the default
+	ExcludeDeleted Deleted = iota
+	// IncludeDeleted shows all items
+	IncludeDeleted
+	// OnlyDeleted shows only soft-deleted items
+	OnlyDeleted
+)
+
+type deletedKey struct{}
+
+// WithDeleted gets context in which items of entities with soft delete are visible as given.
+// It affects lists, lookups and existence checks.
+func WithDeleted(ctx context.Context, d Deleted) context.Context {
+	return context.WithValue(ctx, deletedKey{}, d)
+}
+
+func deletedFrom(ctx context.Context) Deleted {
+	if d, ok := ctx.Value(deletedKey{}).(Deleted); ok {
+		return d
+	}
+	return ExcludeDeleted
+}
+
+// liveness gets predicates that select items of entity visible as given, nil is returned when entity has no soft delete
+// or when all items are visible.
+func (be *impl) liveness(entity string, d Deleted) []*predicate {
+	ec := be.config.Entity(entity)
+	if ec == nil || ec.SoftDelete == nil || d == IncludeDeleted {
+		return nil
+	}
+	deleted := d == OnlyDeleted
+	var cond string
+	switch {
+	case *ec.SoftDelete.Marker == types.SoftDeleteFlag && deleted:
+		cond = "`%s` <> 0"
+	case *ec.SoftDelete.Marker == types.SoftDeleteFlag:
+		cond = "`%s` = 0"
+	case deleted:
+		cond = "`%s` IS NOT NULL"
+	default:
+		cond = "`%s` IS NULL"
+	}
+	return []*predicate{{sql: fmt.Sprintf(cond, ec.SoftDelete.Column)}}
+}
+
+// deletedMarker gets value that marks row as deleted (or restored).
+func deletedMarker(sc *types.SoftDeleteConfig, deleted bool) interface{} {
+	switch {
+	case *sc.Marker == types.SoftDeleteFlag && deleted:
+		return 1
+	case *sc.Marker == types.SoftDeleteFlag:
+		return 0
+	case deleted:
+		return time.Now().UTC()
+	default:
+		return nil
+	}
+}
+
+// createMarkQuery generates `UPDATE <entity> SET <column> = ? <filter>` query
+func createMarkQuery(entity, column, filter string) string {
+	return fmt.Sprintf("UPDATE `%s` SET `%s` = ? %s", entity, column, filter)
+}
+
+func (be *impl) Restore(ctx context.Context, entity, id string) (res api.UntypedDto, err error) {
+	if !*be.config.Update {
+		return nil, errUpdateNotAllowed
+	}
+	if err = be.writable(entity); err != nil {
+		return nil, err
+	}
+	ec := be.config.Entity(entity)
+	if ec == nil || ec.SoftDelete == nil {
+		return nil, types.NewErrorWithStatus(fmt.Sprintf("entity %s doesn't support soft delete", entity), http.StatusBadRequest)
+	}
+	scope, err := be.scope(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	preds := scope.predicates()
+	deleted := append(scope.predicates(), be.liveness(entity, OnlyDeleted)...)
+	at := be.newTrail(ctx, entity)
+	err = be.withTx(ctx, entity, at, func(ctx context.Context, tx *sql.Tx) error {
+		var before api.UntypedDto
+		if before, err = be.fetchOne(ctx, tx, entity, id, true, deleted); err != nil {
+			return err
+		} else if before == nil {
+			return types.NewErrorWithStatus(fmt.Sprintf("deleted entity of type '%s' with id '%s' was not found", entity, id), http.StatusNotFound)
+		}
+		qry := createMarkQuery(be.table(entity), ec.SoftDelete.Column, createSingleItemFilter(be.config.IdColumn(entity), deleted...))
+		be.l.Debug("SQL", "query", qry)
+		args := append([]interface{}{deletedMarker(ec.SoftDelete, false), id}, predicateArgs(deleted)...)
+		if _, err = tx.ExecContext(ctx, qry, args...); err != nil {
+			return types.WrapError("failed to restore entity", err)
+		}
+		if res, err = be.fetchOne(ctx, tx, entity, id, true, preds); err != nil {
+			return err
+		}
+		at.add(audit.OpRestore, id, before, res)
+		return nil
+	})
+	return res, err
+}
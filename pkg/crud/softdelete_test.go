@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveness(t *testing.T) {
+	be := &impl{config: &types.BackendConfig{
+		Entities: map[string]*types.EntityConfig{
+			"emp":  {SoftDelete: &types.SoftDeleteConfig{Column: "deleted_at", Marker: new(types.SoftDeleteTimestamp)}},
+			"dept": {SoftDelete: &types.SoftDeleteConfig{Column: "is_deleted", Marker: new(types.SoftDeleteFlag)}},
+		},
+	}}
+	assert.Nil(t, be.liveness("salary", ExcludeDeleted))
+	assert.Nil(t, be.liveness("emp", IncludeDeleted))
+	assert.Equal(t, "`deleted_at` IS NULL", be.liveness("emp", ExcludeDeleted)[0].sql)
+	assert.Equal(t, "`deleted_at` IS NOT NULL", be.liveness("emp", OnlyDeleted)[0].sql)
+	assert.Equal(t, "`is_deleted` = 0", be.liveness("dept", ExcludeDeleted)[0].sql)
+	assert.Equal(t, "`is_deleted` <> 0", be.liveness("dept", OnlyDeleted)[0].sql)
+
+	assert.IsType(t, time.Time{}, deletedMarker(be.config.Entity("emp").SoftDelete, true))
+	assert.Nil(t, deletedMarker(be.config.Entity("emp").SoftDelete, false))
+	assert.Equal(t, 1, deletedMarker(be.config.Entity("dept").SoftDelete, true))
+	assert.Equal(t, 0, deletedMarker(be.config.Entity("dept").SoftDelete, false))
+
+	assert.Equal(t, "UPDATE `emp` SET `deleted_at` = ? WHERE `id` = ? LIMIT 1",
+		createMarkQuery("emp", "deleted_at", createSingleItemFilter("id")))
+}
+
+func TestWithDeleted(t *testing.T) {
+	assert.Equal(t, ExcludeDeleted, deletedFrom(context.Background()))
+	assert.Equal(t, OnlyDeleted, deletedFrom(WithDeleted(context.Background(), OnlyDeleted)))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+const (
+	kindRead  = "read"
+	kindWrite = "write"
+)
+
+var (
+	statementTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "db2rest",
+		Name:      "statement_timeouts_total",
+		Help:      "Total number of operations that exceeded statement timeout.",
+	}, []string{"backend", "kind"})
+
+	// MySQL: ER_QUERY_TIMEOUT, MariaDB: ER_STATEMENT_TIMEOUT
+	timeoutErrorCodes = map[uint16]bool{3024: true, 1969: true}
+
+	selectRE = regexp.MustCompile(`(?i)^\s*SELECT\b`)
+)
+
+func isTimeout(ctx context.Context, err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return true
+	}
+	if me, ok := errors.AsType[*mysql.MySQLError](err); ok {
+		return timeoutErrorCodes[me.Number]
+	}
+	return false
+}
+
+// deadline derives context that expires after timeout, if there is any.
+// Returned function must be called with outcome of operation, it releases context
+// and converts timeout into 504 error.
+func (be *impl) deadline(ctx context.Context, kind string, timeout *time.Duration) (context.Context, func(error) error) {
+	if timeout == nil {
+		return ctx, func(err error) error { return err }
+	}
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	return ctx, func(err error) error {
+		defer cancel()
+		if err == nil || !isTimeout(ctx, err) {
+			return err
+		}
+		statementTimeouts.WithLabelValues(be.name, kind).Inc()
+		return types.WrapErrorWithStatus(fmt.Sprintf("%s operation timed out after %v", kind, *timeout),
+			err, http.StatusGatewayTimeout)
+	}
+}
+
+// hint adds MAX_EXECUTION_TIME optimizer hint to SELECT statement, so that MySQL server aborts
+// execution too, not just the client. Other statements and drivers are left intact.
+func (be *impl) hint(qry string, timeout *time.Duration) string {
+	if timeout == nil || *be.config.Driver != types.DefaultDbDriver {
+		return qry
+	}
+	loc := selectRE.FindStringIndex(qry)
+	if loc == nil {
+		return qry
+	}
+	return fmt.Sprintf("%s /*+ MAX_EXECUTION_TIME(%d) */%s", qry[:loc[1]], timeout.Milliseconds(), qry[loc[1]:])
+}
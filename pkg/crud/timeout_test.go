@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHint(t *testing.T) {
+	be := &impl{config: &types.BackendConfig{Driver: new(types.DefaultDbDriver)}}
+	assert.Equal(t, "SELECT * FROM x", be.hint("SELECT * FROM x", nil))
+	assert.Equal(t, "SELECT /*+ MAX_EXECUTION_TIME(1500) */ COUNT(1) FROM x",
+		be.hint("SELECT COUNT(1) FROM x", new(1500*time.Millisecond)))
+	assert.Equal(t, "  select /*+ MAX_EXECUTION_TIME(2000) */ a from x",
+		be.hint("  select a from x", new(2*time.Second)))
+	assert.Equal(t, "WITH a AS (SELECT 1) SELECT * FROM a",
+		be.hint("WITH a AS (SELECT 1) SELECT * FROM a", new(2*time.Second)))
+	be.config.Driver = new("postgres")
+	assert.Equal(t, "SELECT 1", be.hint("SELECT 1", new(time.Second)))
+}
+
+func TestDeadline(t *testing.T) {
+	be := &impl{name: "demo"}
+	var ews *types.ErrorWithStatus
+
+	ctx, done := be.deadline(context.Background(), kindRead, nil)
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+	assert.Nil(t, done(nil))
+
+	ctx, done = be.deadline(context.Background(), kindRead, new(time.Millisecond))
+	<-ctx.Done()
+	err := done(ctx.Err())
+	assert.True(t, errors.As(err, &ews))
+	assert.Equal(t, http.StatusGatewayTimeout, ews.Status)
+
+	_, done = be.deadline(context.Background(), kindWrite, new(time.Minute))
+	err = done(&mysql.MySQLError{Number: 3024})
+	assert.True(t, errors.As(err, &ews))
+	assert.Equal(t, http.StatusGatewayTimeout, ews.Status)
+
+	_, done = be.deadline(context.Background(), kindWrite, new(time.Minute))
+	other := errors.New("boom")
+	assert.Equal(t, other, done(other))
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// layout of DATETIME and TIMESTAMP values in text protocol, when driver doesn't parse them
+const sqlDateTimeLayout = "2006-01-02 15:04:05.999999"
+
+// typeMapper converts values between their SQL and JSON representation.
+type typeMapper struct {
+	decimalAsString bool
+	dateTimeFormat  string
+	dateFormat      string
+	// optional location that times are rendered in
+	loc *time.Location
+}
+
+func newTypeMapper(cfg *types.TypeMappingConfig) *typeMapper {
+	if cfg == nil {
+		cfg = &types.TypeMappingConfig{}
+	}
+	tm := &typeMapper{
+		dateTimeFormat: time.RFC3339Nano,
+		dateFormat:     time.DateOnly,
+		loc:            cfg.Location(),
+	}
+	if cfg.DecimalAsString != nil {
+		tm.decimalAsString = *cfg.DecimalAsString
+	}
+	if cfg.DateTimeFormat != nil {
+		tm.dateTimeFormat = *cfg.DateTimeFormat
+	}
+	if cfg.DateFormat != nil {
+		tm.dateFormat = *cfg.DateFormat
+	}
+	return tm
+}
+
+// asString gets textual form of value as returned by driver
+func asString(val interface{}) string {
+	switch v := val.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseTime gets time out of DATE, DATETIME or TIMESTAMP value, which is already parsed by driver when DSN has parseTime=true.
+func parseTime(val interface{}) (time.Time, bool) {
+	if t, ok := val.(time.Time); ok {
+		return t, true
+	}
+	s := asString(val)
+	for _, layout := range []string{sqlDateTimeLayout, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// mapValue converts value scanned from column into value rendered in JSON.
+// Flag columns (TINYINT(1) and BIT(1)) are rendered as booleans, but only entities have them identified,
+// as column types reported by driver lack display width.
+func (tm *typeMapper) mapValue(dbType string, val interface{}, flag bool) interface{} {
+	if val == nil {
+		return nil
+	}
+	typ := strings.TrimPrefix(dbType, "UNSIGNED ")
+	switch typ {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT", "YEAR":
+		if typ != dbType {
+			if n, err := strconv.ParseUint(asString(val), 10, 64); err == nil {
+				if flag {
+					return n != 0
+				}
+				return n
+			}
+		} else if n, err := strconv.ParseInt(asString(val), 10, 64); err == nil {
+			if flag {
+				return n != 0
+			}
+			return n
+		}
+	case "BIT":
+		if b, ok := val.([]byte); ok && len(b) <= 8 {
+			var n uint64
+			for _, x := range b {
+				n = n<<8 | uint64(x)
+			}
+			if flag {
+				return n != 0
+			}
+			return n
+		}
+	case "FLOAT", "DOUBLE":
+		if f, err := strconv.ParseFloat(asString(val), 64); err == nil {
+			return f
+		}
+	case "DECIMAL":
+		if tm.decimalAsString {
+			return asString(val)
+		}
+		// rendered as number with all digits intact
+		return json.Number(asString(val))
+	case "DATETIME", "TIMESTAMP":
+		if t, ok := parseTime(val); ok {
+			if tm.loc != nil {
+				t = t.In(tm.loc)
+			}
+			return t.Format(tm.dateTimeFormat)
+		}
+		return asString(val)
+	case "DATE":
+		if t, ok := parseTime(val); ok {
+			return t.Format(tm.dateFormat)
+		}
+		return asString(val)
+	case "TIME", "CHAR", "VARCHAR", "ENUM", "TEXT", "TINYTEXT", "MEDIUMTEXT", "LONGTEXT":
+		return asString(val)
+	case "SET":
+		if s := asString(val); len(s) > 0 {
+			return strings.Split(s, ",")
+		}
+		return []string{}
+	case "JSON":
+		if data := []byte(asString(val)); json.Valid(data) {
+			return json.RawMessage(data)
+		}
+		return asString(val)
+	case "GEOMETRY":
+		if b, ok := val.([]byte); ok {
+			if g, err := decodeGeometry(b); err == nil {
+				return g
+			}
+		}
+	}
+	// BINARY, VARBINARY and BLOBs are kept as bytes, which are rendered as base64
+	return val
+}
+
+func (tm *typeMapper) mapEntity(rows *sql.Rows, columns []string, columnTypes []*sql.ColumnType, flags map[string]bool) (res api.UntypedDto, err error) {
+	values := make([]interface{}, len(columns))
+	for i := range values {
+		values[i] = new(interface{})
+	}
+	res = make(api.UntypedDto, len(values))
+	if err = rows.Scan(values...); err != nil {
+		return nil, err
+	}
+	for i, column := range columns {
+		res[column] = tm.mapValue(columnTypes[i].DatabaseTypeName(), *(values[i].(*interface{})), flags[column])
+	}
+	return res, nil
+}
+
+// parseTimeIn parses textual time using first layout that fits.
+func (tm *typeMapper) parseTimeIn(s string, layouts ...string) (time.Time, bool) {
+	loc := tm.loc
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// remapValue does reverse of mapValue, it converts value from JSON payload into value accepted by driver.
+// Values that can't be converted are passed as they are, so that database reports the problem.
+func (tm *typeMapper) remapValue(v interface{}, dbType string) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch strings.TrimPrefix(dbType, "UNSIGNED ") {
+	case "DATETIME", "TIMESTAMP":
+		if s, ok := v.(string); ok {
+			if t, ok := tm.parseTimeIn(s, tm.dateTimeFormat, time.RFC3339Nano, time.DateTime, time.DateOnly); ok {
+				return t
+			}
+		}
+	case "DATE":
+		if s, ok := v.(string); ok {
+			if t, ok := tm.parseTimeIn(s, tm.dateFormat, time.DateOnly, time.RFC3339Nano); ok {
+				return t
+			}
+		}
+	case "BIT":
+		if b, ok := v.(bool); ok {
+			if b {
+				return 1
+			}
+			return 0
+		}
+	case "SET":
+		if items, ok := v.([]interface{}); ok {
+			strs := make([]string, len(items))
+			for i, item := range items {
+				strs[i] = asString(item)
+			}
+			return strings.Join(strs, ",")
+		}
+	case "JSON":
+		// payload holds embedded JSON value, not its text
+		if data, err := json.Marshal(v); err == nil {
+			return string(data)
+		}
+	case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB":
+		if s, ok := v.(string); ok {
+			if data, err := base64.StdEncoding.DecodeString(s); err == nil {
+				return data
+			}
+		}
+	case "GEOMETRY":
+		if g, ok := v.(map[string]interface{}); ok {
+			if data, err := encodeGeometry(g); err == nil {
+				return data
+			}
+		}
+	}
+	return v
+}
+
+func (tm *typeMapper) remapBody(md *ttlcache.Item[string, map[string]*sql.ColumnType], body api.UntypedDto) api.UntypedDto {
+	for key, val := range body {
+		if ct, ok := md.Value()[key]; ok {
+			body[key] = tm.remapValue(val, ct.DatabaseTypeName())
+		}
+	}
+	return body
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapValue(t *testing.T) {
+	tm := newTypeMapper(nil)
+	assert.Nil(t, tm.mapValue("INT", nil, false))
+	assert.Equal(t, int64(-5), tm.mapValue("INT", []byte("-5"), false))
+	assert.Equal(t, int64(7), tm.mapValue("BIGINT", int64(7), false))
+	assert.Equal(t, uint64(18446744073709551615), tm.mapValue("UNSIGNED BIGINT", []byte("18446744073709551615"), false))
+	assert.Equal(t, true, tm.mapValue("TINYINT", int64(1), true))
+	assert.Equal(t, int64(1), tm.mapValue("TINYINT", int64(1), false))
+	assert.Equal(t, false, tm.mapValue("BIT", []byte{0}, true))
+	assert.Equal(t, uint64(258), tm.mapValue("BIT", []byte{1, 2}, false))
+	assert.Equal(t, 0.1, tm.mapValue("FLOAT", float32(0.1), false))
+	assert.Equal(t, json.Number("12345678901234567890.12"), tm.mapValue("DECIMAL", []byte("12345678901234567890.12"), false))
+	assert.Equal(t, int64(2024), tm.mapValue("YEAR", []byte("2024"), false))
+	assert.Equal(t, "838:59:59", tm.mapValue("TIME", []byte("838:59:59"), false))
+	assert.Equal(t, "2024-03-01", tm.mapValue("DATE", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false))
+	assert.Equal(t, "2024-03-01T10:20:30.5Z", tm.mapValue("DATETIME", []byte("2024-03-01 10:20:30.5"), false))
+	assert.Equal(t, "0000-00-00 00:00:00", tm.mapValue("DATETIME", []byte("0000-00-00 00:00:00"), false))
+	assert.Equal(t, []string{"a", "b"}, tm.mapValue("SET", []byte("a,b"), false))
+	assert.Equal(t, []string{}, tm.mapValue("SET", []byte(""), false))
+	assert.Equal(t, json.RawMessage(`{"a":[1,2]}`), tm.mapValue("JSON", []byte(`{"a":[1,2]}`), false))
+	assert.Equal(t, []byte{1, 2}, tm.mapValue("BLOB", []byte{1, 2}, false))
+
+	tc := &types.TypeMappingConfig{
+		DecimalAsString: &types.TRUE,
+		DateTimeFormat:  new(time.DateTime),
+		DateFormat:      new("02.01.2006"),
+		TimeZone:        new("Europe/Bratislava"),
+	}
+	assert.NoError(t, (&types.Config{Backends: types.Backends{
+		"demo": {TypeMapping: tc},
+	}}).CheckAndNormalize())
+	tm = newTypeMapper(tc)
+	assert.Equal(t, "1.50", tm.mapValue("DECIMAL", []byte("1.50"), false))
+	assert.Equal(t, "01.03.2024", tm.mapValue("DATE", []byte("2024-03-01"), false))
+	assert.Equal(t, "2024-03-01 11:20:30", tm.mapValue("TIMESTAMP", time.Date(2024, 3, 1, 10, 20, 30, 0, time.UTC), false))
+}
+
+func TestRemapValue(t *testing.T) {
+	tm := newTypeMapper(nil)
+	assert.Nil(t, tm.remapValue(nil, "JSON"))
+	assert.Equal(t, `{"a":1}`, tm.remapValue(map[string]interface{}{"a": 1.0}, "JSON"))
+	assert.Equal(t, `"text"`, tm.remapValue("text", "JSON"))
+	assert.Equal(t, "a,b", tm.remapValue([]interface{}{"a", "b"}, "SET"))
+	assert.Equal(t, 1, tm.remapValue(true, "BIT"))
+	assert.Equal(t, []byte("hi"), tm.remapValue("aGk=", "VARBINARY"))
+	assert.Equal(t, "not base64!", tm.remapValue("not base64!", "BLOB"))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), tm.remapValue("2024-03-01", "DATE"))
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 20, 30, 0, time.UTC), tm.remapValue("2024-03-01 10:20:30", "DATETIME"))
+	assert.Equal(t, "12.50", tm.remapValue("12.50", "DECIMAL"))
+
+	tm = newTypeMapper(&types.TypeMappingConfig{DateFormat: new("02.01.2006")})
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), tm.remapValue("01.03.2024", "DATE"))
+}
+
+func TestGeometry(t *testing.T) {
+	tm := newTypeMapper(nil)
+	for _, g := range []string{
+		`{"type":"Point","coordinates":[1.5,2],"srid":4326}`,
+		`{"type":"LineString","coordinates":[[0,0],[1,1]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+		`{"type":"MultiPoint","coordinates":[[0,0],[1,1]]}`,
+		`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}`,
+		`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]},{"type":"LineString","coordinates":[[0,0],[1,1]]}]}`,
+	} {
+		var in map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(g), &in))
+		data, ok := tm.remapValue(in, "GEOMETRY").([]byte)
+		assert.True(t, ok, g)
+		out, err := json.Marshal(tm.mapValue("GEOMETRY", data, false))
+		assert.NoError(t, err)
+		assert.JSONEq(t, g, string(out))
+	}
+	// SRID 0 followed by POINT(1 2) in big endian
+	g, err := decodeGeometry([]byte{0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0x3f, 0xf0, 0, 0, 0, 0, 0, 0, 0x40, 0, 0, 0, 0, 0, 0, 0})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"type": "Point", "coordinates": []interface{}{1.0, 2.0}}, g)
+	_, err = decodeGeometry([]byte{0, 0, 0, 0, 1, 2, 0, 0, 0, 0xff, 0xff, 0xff, 0x7f})
+	assert.Error(t, err)
+	_, err = encodeGeometry(map[string]interface{}{"type": "Circle"})
+	assert.Error(t, err)
+}
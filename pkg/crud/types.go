@@ -36,6 +36,7 @@ var (
 	errReadNotAllowed   = types.NewErrorWithStatus("read"+notAllowedSuffix, http.StatusMethodNotAllowed)
 	errUpdateNotAllowed = types.NewErrorWithStatus("update"+notAllowedSuffix, http.StatusMethodNotAllowed)
 	errDeleteNotAllowed = types.NewErrorWithStatus("delete"+notAllowedSuffix, http.StatusMethodNotAllowed)
+	errOutOfScope       = types.NewErrorWithStatus("item would be outside of scope permitted by row policy", http.StatusForbidden)
 )
 
 // Interface is API to perform CRUD operation against backend
@@ -52,8 +53,12 @@ type Interface interface {
 	Update(ctx context.Context, entity, id string, body api.UntypedDto) (api.UntypedDto, error)
 	// Create creates new item
 	Create(ctx context.Context, entity string, body api.UntypedDto) (api.UntypedDto, error)
-	// Delete deletes item by its ID
+	// Delete deletes item by its ID, or marks it as deleted when entity has soft delete
 	Delete(ctx context.Context, entity string, id string) error
+	// Purge deletes item by its ID physically, even if entity has soft delete
+	Purge(ctx context.Context, entity string, id string) error
+	// Restore undoes soft delete of item by its ID and returns restored item
+	Restore(ctx context.Context, entity, id string) (api.UntypedDto, error)
 	// MultiDelete deletes items that has provided ids
 	MultiDelete(ctx context.Context, entity string, ids []interface{}) error
 	// MultiUpdate updates multiple items in one shot
@@ -62,7 +67,27 @@ type Interface interface {
 	// if replace is set to true, then items are removed in backend prior to creating, if they exist.
 	MultiCreate(ctx context.Context, entity string, replace bool, objs []api.UntypedDto) error
 	// QueryNamed executes named query that was provided in configuration.
+	// Arguments are either positional, or sql.NamedArg when query declares parameters.
+	// Value of sql.NamedArg is raw request value (string or []string), that is validated and coerced to declared type.
 	QueryNamed(ctx context.Context, name string, qry query.Interface, args ...interface{}) (*api.PagedResult, error)
+	// DescribeEntity gets schema of entity from information_schema of database
+	DescribeEntity(ctx context.Context, entity string) (*api.EntitySchema, error)
+	// DescribeQueries describes all named queries, sorted by name.
+	// Permissions are left for caller to fill in.
+	DescribeQueries(ctx context.Context) ([]api.QueryInfo, error)
+	// FlushCache drops all cached responses of backend
+	FlushCache()
+	// ExecCommand executes named command that was provided in configuration.
+	// Arguments are sql.NamedArg, see QueryNamed.
+	ExecCommand(ctx context.Context, name string, args ...interface{}) (*api.CommandResult, error)
+	// OpenBlob opens content of binary column of single item for reading, caller must close returned blob.
+	OpenBlob(ctx context.Context, entity, id, column string) (*Blob, error)
+	// WriteBlob replaces content of binary column of single item with data read from r.
+	WriteBlob(ctx context.Context, entity, id, column, contentType string, r io.Reader) error
+	// Visible checks that item, which doesn't need to exist in database, satisfies row policy of entity
+	// bound to principal in context. Item must hold all columns that policy refers to.
+	// Nil item is visible only when entity has no policy, error still tells that policy can't be bound.
+	Visible(ctx context.Context, entity string, item api.UntypedDto) (bool, error)
 }
 
 type NameToCrudMap map[string]Interface
@@ -77,6 +102,6 @@ func (m *NameToCrudMap) Close() error {
 	return errors.Join(errs...)
 }
 
-func New(be *types.BackendConfig, logger *slog.Logger) Interface {
-	return newImpl(be, WithLogger(logger))
+func New(be *types.BackendConfig, logger *slog.Logger, opts ...Opt) Interface {
+	return newImpl(be, append([]Opt{WithLogger(logger)}, opts...)...)
 }
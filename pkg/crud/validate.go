@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+)
+
+const (
+	payloadCreate = "create"
+	payloadUpdate = "update"
+)
+
+// jsonPointer renders location within document as JSON pointer (RFC 6901).
+func jsonPointer(loc []string) string {
+	var sb strings.Builder
+	for _, tok := range loc {
+		sb.WriteByte('/')
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(tok, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
+
+// fieldErrors flattens tree of validation errors into list of problems of individual fields.
+func fieldErrors(ve *jsonschema.ValidationError, prefix string, res []types.FieldError) []types.FieldError {
+	if len(ve.Causes) > 0 {
+		for _, c := range ve.Causes {
+			res = fieldErrors(c, prefix, res)
+		}
+		return res
+	}
+	loc := prefix + jsonPointer(ve.InstanceLocation)
+	switch k := ve.ErrorKind.(type) {
+	case *kind.Required:
+		for _, prop := range k.Missing {
+			res = append(res, types.FieldError{Field: loc + jsonPointer([]string{prop}), Message: "missing required property"})
+		}
+	case *kind.AdditionalProperties:
+		for _, prop := range k.Properties {
+			res = append(res, types.FieldError{Field: loc + jsonPointer([]string{prop}), Message: "unknown property"})
+		}
+	default:
+		res = append(res, types.FieldError{Field: loc, Message: ve.BasicOutput().Error.String()})
+	}
+	return res
+}
+
+// derivedSchema gets JSON schema of payload derived from columns of entity.
+// Columns forced by row policy are never required, as they are filled in from principal.
+func (be *impl) derivedSchema(es *api.EntitySchema, create bool) (interface{}, error) {
+	doc := EntityJSONSchema(es, create)
+	if rp, ok := be.entPolicies[es.Name]; ok {
+		ec := be.config.Entity(es.Name)
+		if req, ok := doc["required"].([]string); ok {
+			doc["required"] = slices.DeleteFunc(req, func(field string) bool {
+				_, forced := rp.forced[ec.Column(field)]
+				return forced
+			})
+		}
+	}
+	// compiler only understands generic JSON values
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return jsonschema.UnmarshalJSON(bytes.NewReader(data))
+}
+
+// payloadSchema gets compiled schema of payload for create or update of entity.
+func (be *impl) payloadSchema(ctx context.Context, entity, op string) (*jsonschema.Schema, error) {
+	key := entity + "/" + op
+	if item := be.schemaCache.Get(key); item != nil {
+		return item.Value(), nil
+	}
+	es, err := be.entitySchema(ctx, be.config.DB(), entity)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := be.derivedSchema(be.apiSchema(es), op == payloadCreate)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := compilePayloadSchema(be.config.Entity(entity), fmt.Sprintf("urn:db2rest:%s:%s:%s", be.name, entity, op), doc)
+	if err != nil {
+		return nil, types.WrapError("invalid schema of entity "+entity, err)
+	}
+	be.schemaCache.Set(key, sch, ttlcache.DefaultTTL)
+	return sch, nil
+}
+
+// compilePayloadSchema compiles derived schema, combined with user-supplied schema file of entity (if any) using allOf.
+func compilePayloadSchema(ec *types.EntityConfig, loc string, doc interface{}) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	if err := c.AddResource(loc+":derived", doc); err != nil {
+		return nil, err
+	}
+	refs := []interface{}{map[string]interface{}{"$ref": loc + ":derived"}}
+	if ec != nil && ec.SchemaFile != nil {
+		path, err := filepath.Abs(*ec.SchemaFile)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = loadJSONSchema(path); err != nil {
+			return nil, err
+		}
+		fileURL := (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+		if err = c.AddResource(fileURL, doc); err != nil {
+			return nil, err
+		}
+		refs = append(refs, map[string]interface{}{"$ref": fileURL})
+	}
+	if err := c.AddResource(loc, map[string]interface{}{"allOf": refs}); err != nil {
+		return nil, err
+	}
+	return c.Compile(loc)
+}
+
+func loadJSONSchema(path string) (interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return jsonschema.UnmarshalJSON(f)
+}
+
+// validate checks payloads of create or update against schema of entity, before anything is written.
+// All problems are reported at once in *types.ValidationError. Objects of bulk operation are located
+// by their index, as in request body.
+func (be *impl) validate(ctx context.Context, entity, op string, bulk bool, objs ...api.UntypedDto) error {
+	var errs []types.FieldError
+	if op == payloadUpdate && bulk {
+		// ID locates row to update, so it's required regardless of schema
+		idField := be.config.IdField(entity)
+		for i, obj := range objs {
+			if obj[idField] == nil {
+				errs = append(errs, types.FieldError{
+					Field:   fmt.Sprintf("/objects/%d", i) + jsonPointer([]string{idField}),
+					Message: "missing required property",
+				})
+			}
+		}
+	}
+	if *be.config.ValidatePayloads {
+		var err error
+		if errs, err = be.validateSchema(ctx, entity, op, bulk, errs, objs...); err != nil {
+			return err
+		}
+	}
+	if len(errs) > 0 {
+		return &types.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// validateSchema validates objects against payload schema of entity and appends all problems to errs.
+func (be *impl) validateSchema(ctx context.Context, entity, op string, bulk bool, errs []types.FieldError, objs ...api.UntypedDto) ([]types.FieldError, error) {
+	sch, err := be.payloadSchema(ctx, entity, op)
+	if err != nil {
+		return nil, err
+	}
+	// these are set by bridge itself, regardless of payload
+	skip := map[string]bool{}
+	if rp, ok := be.entPolicies[entity]; ok {
+		for col := range rp.forced {
+			skip[be.config.Entity(entity).Field(col)] = true
+		}
+	}
+	// managed columns are filled in by bridge, values in payload are ignored
+	if ec := be.config.Entity(entity); ec != nil {
+		for _, col := range ec.Managed.Columns() {
+			skip[ec.Field(col)] = true
+		}
+	}
+	if op == payloadUpdate {
+		// ID only locates row to update, it's given as string in bulk updates
+		skip[be.config.IdField(entity)] = true
+	}
+	for i, obj := range objs {
+		v := make(map[string]interface{}, len(obj))
+		for col, val := range obj {
+			if !skip[col] {
+				v[col] = val
+			}
+		}
+		prefix := ""
+		if bulk {
+			prefix = fmt.Sprintf("/objects/%d", i)
+		}
+		if err = sch.Validate(v); err != nil {
+			ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+			if !ok {
+				return nil, types.WrapError("failed to validate payload", err)
+			}
+			errs = fieldErrors(ve, prefix, errs)
+		}
+	}
+	return errs, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonPointer(t *testing.T) {
+	assert.Equal(t, "", jsonPointer(nil))
+	assert.Equal(t, "/a~1b/c~0d/0", jsonPointer([]string{"a/b", "c~d", "0"}))
+}
+
+func TestValidate(t *testing.T) {
+	es := &api.EntitySchema{Name: "emp", Columns: []api.SchemaColumn{
+		{Name: "id", Type: "INT", ColumnType: "int", AutoIncrement: true},
+		{Name: "name", Type: "VARCHAR", ColumnType: "varchar(5)", MaxLength: new(int64(5))},
+		{Name: "tenant", Type: "VARCHAR", ColumnType: "varchar(10)", MaxLength: new(int64(10))},
+		{Name: "active", Type: "TINYINT", ColumnType: "tinyint(1)", Default: new("1")},
+	}}
+	sf := filepath.Join(t.TempDir(), "emp.json")
+	assert.NoError(t, os.WriteFile(sf, []byte(`{"properties":{"name":{"pattern":"^[A-Z]"}}}`), 0o644))
+	be := &impl{
+		name: "demo",
+		config: &types.BackendConfig{
+			ValidatePayloads: &types.TRUE,
+			IdMap:            &map[string]string{},
+			Entities:         map[string]*types.EntityConfig{"emp": {SchemaFile: &sf}},
+		},
+		entPolicies: map[string]*rowPolicy{"emp": compileRowPolicy("tenant = ${claims.tenant}")},
+		schemaCache: ttlcache.New[string, *jsonschema.Schema](),
+	}
+	for _, op := range []string{payloadCreate, payloadUpdate} {
+		doc, err := be.derivedSchema(es, op == payloadCreate)
+		assert.NoError(t, err)
+		sch, err := compilePayloadSchema(be.config.Entity("emp"), "urn:test:emp:"+op, doc)
+		assert.NoError(t, err)
+		be.schemaCache.Set("emp/"+op, sch, ttlcache.DefaultTTL)
+	}
+
+	assert.NoError(t, be.validate(t.Context(), "emp", payloadCreate, false, api.UntypedDto{
+		"name": "Alice", "active": true, "tenant": "forced",
+	}))
+	assert.NoError(t, be.validate(t.Context(), "emp", payloadUpdate, true, api.UntypedDto{"id": "1", "active": 0.0}))
+
+	err := be.validate(t.Context(), "emp", payloadCreate, false, api.UntypedDto{"salary": 1.0})
+	assert.Equal(t, &types.ValidationError{Errors: []types.FieldError{
+		{Field: "/name", Message: "missing required property"},
+		{Field: "/salary", Message: "unknown property"},
+	}}, err)
+
+	err = be.validate(t.Context(), "emp", payloadUpdate, true, api.UntypedDto{"id": "1"}, api.UntypedDto{"id": "2", "name": "bob"})
+	var ve *types.ValidationError
+	assert.ErrorAs(t, err, &ve)
+	assert.Len(t, ve.Errors, 1)
+	assert.Equal(t, "/objects/1/name", ve.Errors[0].Field)
+
+	// ID is required in bulk update, but not type-checked
+	assert.NoError(t, be.validate(t.Context(), "emp", payloadUpdate, true, api.UntypedDto{"id": 1.0, "name": "Bob"}))
+	err = be.validate(t.Context(), "emp", payloadUpdate, true, api.UntypedDto{"id": "1"}, api.UntypedDto{"active": true})
+	assert.Equal(t, &types.ValidationError{Errors: []types.FieldError{
+		{Field: "/objects/1/id", Message: "missing required property"},
+	}}, err)
+
+	err = be.validate(t.Context(), "emp", payloadUpdate, false, api.UntypedDto{"name": "Robert"})
+	assert.ErrorAs(t, err, &ve)
+	assert.Equal(t, "/name", ve.Errors[0].Field)
+
+	be.config.ValidatePayloads = &types.FALSE
+	assert.NoError(t, be.validate(t.Context(), "emp", payloadCreate, false, api.UntypedDto{"salary": 1.0}))
+	assert.Error(t, be.validate(t.Context(), "emp", payloadUpdate, true, api.UntypedDto{"name": "bob"}))
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// source gets SQL that items of entity are selected from.
+// Table is referenced by its name, while SQL of virtual entity is wrapped as derived table.
+func (be *impl) source(entity string) string {
+	if ec := be.config.Entity(entity); ec.Virtual() {
+		return fmt.Sprintf("(%s) AS `%s`", *ec.SQL, entity)
+	}
+	return "`" + be.table(entity) + "`"
+}
+
+// writable ensures that entity can be modified, virtual entities are read-only.
+func (be *impl) writable(entity string) error {
+	if be.config.Entity(entity).Virtual() {
+		return types.NewErrorWithStatus(fmt.Sprintf("entity %s is virtual, hence read-only", entity), http.StatusMethodNotAllowed)
+	}
+	return nil
+}
+
+// addressable ensures that items of virtual entity can be accessed by ID, that is, its SQL yields ID column.
+func (be *impl) addressable(ctx context.Context, q querier, entity string) error {
+	if !be.config.Entity(entity).Virtual() {
+		return nil
+	}
+	es, err := be.entitySchema(ctx, q, entity)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(es.Columns, func(sc api.SchemaColumn) bool {
+		return sc.Name == es.IdColumn
+	}) {
+		return types.NewErrorWithStatus(fmt.Sprintf("virtual entity %s has no ID column %s", entity, es.IdColumn), http.StatusNotFound)
+	}
+	return nil
+}
+
+// describeVirtual describes virtual entity using metadata of its result set, as there is nothing in information_schema.
+func (be *impl) describeVirtual(ctx context.Context, q querier, entity string) (*api.EntitySchema, error) {
+	qry := fmt.Sprintf("SELECT * FROM %s LIMIT 0", be.source(entity))
+	be.l.Debug("SQL", "query", qry)
+	rows, err := q.QueryContext(ctx, qry)
+	if err != nil {
+		return nil, types.WrapError("failed to describe virtual entity "+entity, err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	var colTypes []*sql.ColumnType
+	if _, colTypes, err = getRowMetadata(rows); err != nil {
+		return nil, types.WrapError("failed to describe virtual entity "+entity, err)
+	}
+	schema := &api.EntitySchema{
+		Name:        entity,
+		IdColumn:    be.config.IdColumn(entity),
+		Columns:     make([]api.SchemaColumn, 0, len(colTypes)),
+		PrimaryKey:  []string{},
+		UniqueKeys:  []api.UniqueKey{},
+		ForeignKeys: []api.ForeignKey{},
+	}
+	for _, ct := range colTypes {
+		schema.Columns = append(schema.Columns, virtualColumn(ct))
+	}
+	return schema, nil
+}
+
+// virtualColumn converts metadata of result set column into schema column.
+func virtualColumn(ct *sql.ColumnType) api.SchemaColumn {
+	// MySQL driver reports unsigned types as "UNSIGNED INT" and so on
+	typ, unsigned := strings.CutPrefix(ct.DatabaseTypeName(), "UNSIGNED ")
+	col := api.SchemaColumn{
+		Name:       ct.Name(),
+		Type:       typ,
+		ColumnType: strings.ToLower(typ),
+	}
+	if unsigned {
+		col.ColumnType += " unsigned"
+	}
+	if nullable, ok := ct.Nullable(); ok {
+		col.Nullable = nullable
+	}
+	if l, ok := ct.Length(); ok && l > 0 {
+		col.MaxLength = &l
+	}
+	if p, s, ok := ct.DecimalSize(); ok {
+		col.Precision, col.Scale = new(int(p)), new(int(s))
+	}
+	return col
+}
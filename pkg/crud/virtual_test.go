@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualEntity(t *testing.T) {
+	be := &impl{
+		config: &types.BackendConfig{
+			IdMap: &map[string]string{"top_emp": "emp_no"},
+			Entities: map[string]*types.EntityConfig{
+				"top_emp": {SQL: new("SELECT emp_no, name FROM emp WHERE salary > 1000")},
+				"stats":   {SQL: new("SELECT dept, COUNT(*) AS cnt FROM emp GROUP BY dept")},
+				"emp":     {MaskedColumns: []string{"salary"}},
+			},
+		},
+		entSchemas: ttlcache.New[string, *api.EntitySchema](),
+	}
+	assert.Equal(t, "`emp`", be.source("emp"))
+	assert.Equal(t, "`dept`", be.source("dept"))
+	assert.Equal(t, "(SELECT emp_no, name FROM emp WHERE salary > 1000) AS `top_emp`", be.source("top_emp"))
+	assert.Equal(t, "SELECT * FROM (SELECT emp_no, name FROM emp WHERE salary > 1000) AS `top_emp` WHERE `emp_no` = ? LIMIT 1",
+		createSingleSelectFromQuery(be.source("top_emp"), be.config.IdColumn("top_emp")))
+
+	assert.NoError(t, be.writable("emp"))
+	err := be.writable("top_emp")
+	assert.Equal(t, http.StatusMethodNotAllowed, err.(*types.ErrorWithStatus).Status)
+
+	be.entSchemas.Set("top_emp", &api.EntitySchema{Name: "top_emp", IdColumn: "emp_no",
+		Columns: []api.SchemaColumn{{Name: "emp_no"}, {Name: "name"}}}, ttlcache.DefaultTTL)
+	be.entSchemas.Set("stats", &api.EntitySchema{Name: "stats", IdColumn: "id",
+		Columns: []api.SchemaColumn{{Name: "dept"}, {Name: "cnt"}}}, ttlcache.DefaultTTL)
+	assert.NoError(t, be.addressable(context.Background(), nil, "emp"))
+	assert.NoError(t, be.addressable(context.Background(), nil, "top_emp"))
+	err = be.addressable(context.Background(), nil, "stats")
+	assert.Equal(t, http.StatusNotFound, err.(*types.ErrorWithStatus).Status)
+}
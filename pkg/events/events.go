@@ -0,0 +1,152 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// Event describes change of single item that was committed.
+type Event struct {
+	// Unique ID of event, IDs sort in order in which events were published
+	EventID   string          `json:"event_id"`
+	Time      time.Time       `json:"time"`
+	Principal string          `json:"principal,omitempty"`
+	Backend   string          `json:"backend"`
+	Entity    string          `json:"entity"`
+	Operation audit.Operation `json:"operation"`
+	// ID of changed item
+	ID  interface{}    `json:"id,omitempty"`
+	Old api.UntypedDto `json:"old,omitempty"`
+	New api.UntypedDto `json:"new,omitempty"`
+	// full, unredacted item, see audit.Record.Row. It's not part of payload.
+	Row api.UntypedDto `json:"-"`
+}
+
+// Publisher turns records of committed changes into events and hands them over to subscribers.
+// Nil *Publisher is valid and means that publishing is disabled.
+type Publisher struct {
+	l      *slog.Logger
+	hooks  []*webhook
+	stream *Stream
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	// unix time of last event in nanoseconds, it's base of event ID
+	last int64
+}
+
+// New creates Publisher from configuration and starts delivery of events that are still pending from previous run.
+// Nil is returned if cfg is nil.
+func New(cfg *types.EventsConfig, l *slog.Logger) (*Publisher, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Publisher{l: l, cancel: cancel}
+	if cfg.Stream != nil {
+		p.stream = newStream(*cfg.Stream.BufferSize, *cfg.Stream.Heartbeat)
+	}
+	for _, wc := range cfg.Webhooks {
+		dir := filepath.Join(cfg.QueueDir, wc.Name)
+		if err := os.MkdirAll(filepath.Join(dir, failedDir), 0o700); err != nil {
+			cancel()
+			return nil, err
+		}
+		p.hooks = append(p.hooks, newWebhook(wc, dir, l.With("webhook", wc.Name)))
+	}
+	for _, wh := range p.hooks {
+		go wh.run(ctx)
+	}
+	return p, nil
+}
+
+// Publish publishes event for every record, except those of named commands, as they don't describe items.
+// Change is already committed at this point, so failures are only logged.
+func (p *Publisher) Publish(ctx context.Context, recs []*audit.Record) {
+	if p == nil {
+		return
+	}
+	for _, rec := range recs {
+		if rec.Operation == audit.OpCommand {
+			continue
+		}
+		ev := p.newEvent(rec)
+		for _, wh := range p.hooks {
+			if !wh.matches(ev) {
+				continue
+			}
+			if err := wh.enqueue(ev); err != nil {
+				p.l.ErrorContext(ctx, "unable to enqueue event", "err", err, "webhook", wh.cfg.Name, "event", ev.EventID)
+			}
+		}
+	}
+}
+
+// newEvent creates event from record and puts it into stream. IDs are assigned in same critical section,
+// so that order of events in stream matches order of their IDs.
+func (p *Publisher) newEvent(rec *audit.Record) *Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.last = max(time.Now().UnixNano(), p.last+1)
+	ev := &Event{
+		EventID:   fmt.Sprintf("%016x", p.last),
+		Time:      rec.Time,
+		Principal: rec.Principal,
+		Backend:   rec.Backend,
+		Entity:    rec.Entity,
+		Operation: rec.Operation,
+		ID:        rec.ID,
+		Old:       rec.Before,
+		New:       rec.After,
+		Row:       rec.Row,
+	}
+	if p.stream != nil {
+		p.stream.publish(ev)
+	}
+	return ev
+}
+
+// Stream gets change stream, or nil if it's not enabled.
+func (p *Publisher) Stream() *Stream {
+	if p == nil {
+		return nil
+	}
+	return p.stream
+}
+
+// Close stops delivery. Pending deliveries stay in queue and are resumed by next Publisher.
+func (p *Publisher) Close() error {
+	if p == nil {
+		return nil
+	}
+	p.cancel()
+	for _, wh := range p.hooks {
+		<-wh.done
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// number of events that can wait for slow subscriber, before it's dropped
+const subscriberBuffer = 256
+
+// Stream keeps recent events in ring buffer and fans them out to subscribers.
+type Stream struct {
+	heartbeat time.Duration
+
+	mu   sync.Mutex
+	ring []*Event
+	// index of oldest event in ring
+	head int
+	subs map[*Subscription]struct{}
+}
+
+// Subscription receives events published after it was made.
+type Subscription struct {
+	s  *Stream
+	ch chan *Event
+}
+
+func newStream(size int, heartbeat time.Duration) *Stream {
+	return &Stream{
+		heartbeat: heartbeat,
+		ring:      make([]*Event, 0, size),
+		subs:      map[*Subscription]struct{}{},
+	}
+}
+
+// Heartbeat gets interval in which idle subscribers should be sent heartbeat.
+func (s *Stream) Heartbeat() time.Duration {
+	return s.heartbeat
+}
+
+func (s *Stream) publish(ev *Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.ring) < cap(s.ring) {
+		s.ring = append(s.ring, ev)
+	} else {
+		s.ring[s.head] = ev
+		s.head = (s.head + 1) % len(s.ring)
+	}
+	for sub := range s.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			// subscriber can't keep up, it can resume from ring buffer after reconnect
+			delete(s.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// Subscribe makes new subscription. When lastEventID is not empty, buffered events published after it are replayed first.
+// Events that are no longer in buffer can't be replayed.
+func (s *Stream) Subscribe(lastEventID string) *Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var replay []*Event
+	if lastEventID != "" {
+		for i := range s.ring {
+			// IDs sort in order of publishing
+			if ev := s.ring[(s.head+i)%len(s.ring)]; ev.EventID > lastEventID {
+				replay = append(replay, ev)
+			}
+		}
+	}
+	sub := &Subscription{s: s, ch: make(chan *Event, len(replay)+subscriberBuffer)}
+	for _, ev := range replay {
+		sub.ch <- ev
+	}
+	s.subs[sub] = struct{}{}
+	return sub
+}
+
+// Events gets channel of events. Channel is closed when subscriber fails to keep up with publishing.
+func (sub *Subscription) Events() <-chan *Event {
+	return sub.ch
+}
+
+// Close cancels subscription.
+func (sub *Subscription) Close() {
+	sub.s.mu.Lock()
+	defer sub.s.mu.Unlock()
+	if _, ok := sub.s.subs[sub]; ok {
+		delete(sub.s.subs, sub)
+		close(sub.ch)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func ids(evs []*Event) []string {
+	res := make([]string, 0, len(evs))
+	for _, ev := range evs {
+		res = append(res, ev.EventID)
+	}
+	return res
+}
+
+func drain(sub *Subscription) []*Event {
+	var res []*Event
+	for {
+		select {
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return res
+			}
+			res = append(res, ev)
+		default:
+			return res
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	s := newStream(3, time.Second)
+	live := s.Subscribe("")
+	for _, id := range []string{"01", "02", "03", "04", "05"} {
+		s.publish(&Event{EventID: id})
+	}
+	assert.Equal(t, []string{"01", "02", "03", "04", "05"}, ids(drain(live)))
+
+	// only last 3 events are buffered
+	assert.Equal(t, []string{"04", "05"}, ids(drain(s.Subscribe("03"))))
+	assert.Equal(t, []string{"03", "04", "05"}, ids(drain(s.Subscribe("00"))))
+	assert.Empty(t, drain(s.Subscribe("05")))
+	// no replay without ID
+	assert.Empty(t, drain(s.Subscribe("")))
+
+	live.Close()
+	live.Close()
+	s.publish(&Event{EventID: "06"})
+	_, ok := <-live.Events()
+	assert.False(t, ok)
+}
+
+func TestStreamDropsSlowSubscriber(t *testing.T) {
+	s := newStream(1, time.Second)
+	slow := s.Subscribe("")
+	for i := 0; i <= subscriberBuffer; i++ {
+		s.publish(&Event{EventID: "x"})
+	}
+	assert.Len(t, drain(slow), subscriberBuffer)
+	_, ok := <-slow.Events()
+	assert.False(t, ok)
+	assert.Empty(t, s.subs)
+}
+
+func TestPublishToStream(t *testing.T) {
+	p, err := New(&types.EventsConfig{Stream: &types.StreamConfig{BufferSize: new(10), Heartbeat: new(time.Second)}}, slog.Default())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close())
+	}()
+	sub := p.Stream().Subscribe("")
+	p.Publish(context.TODO(), []*audit.Record{
+		testRecord("emp", audit.OpCreate),
+		testRecord("emp", audit.OpCommand),
+		testRecord("emp", audit.OpDelete),
+	})
+	evs := drain(sub)
+	assert.Len(t, evs, 2)
+	assert.Less(t, evs[0].EventID, evs[1].EventID)
+	assert.Equal(t, audit.OpDelete, evs[1].Operation)
+	// full row is available to subscribers, but it's not part of payload
+	assert.Equal(t, "s", evs[0].Row["secret"])
+	payload, err := json.Marshal(evs[0])
+	assert.NoError(t, err)
+	assert.NotContains(t, string(payload), "secret")
+	assert.Equal(t, time.Second, p.Stream().Heartbeat())
+
+	var none *Publisher
+	assert.Nil(t, none.Stream())
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+const (
+	// HeaderEventID carries ID of delivered event, receivers can use it to detect duplicate deliveries
+	HeaderEventID = "X-Db2rest-Event-Id"
+	// HeaderTimestamp carries unix time of delivery attempt
+	HeaderTimestamp = "X-Db2rest-Timestamp"
+	// HeaderSignature carries signature of delivery, see Sign
+	HeaderSignature = "X-Db2rest-Signature"
+
+	// subdirectory of queue where deliveries that ran out of attempts are moved to
+	failedDir = "failed"
+	// how long is idle worker waiting before it rescans its queue
+	idleWait = time.Minute
+)
+
+// Sign computes signature of delivery as "sha256=" followed by hex-encoded HMAC-SHA256 of "<timestamp>.<body>".
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// delivery is event waiting to be delivered to webhook, persisted as JSON file in queue directory.
+type delivery struct {
+	Event    *Event    `json:"event"`
+	Attempts int       `json:"attempts"`
+	Next     time.Time `json:"next"`
+}
+
+// webhook delivers events to single subscription. Every event is stored in queue directory first,
+// then worker posts it and removes it once it's accepted. Failed attempts are retried with exponential backoff,
+// so events might be delivered out of order or more than once.
+type webhook struct {
+	cfg    *types.WebhookConfig
+	dir    string
+	client *http.Client
+	l      *slog.Logger
+	wake   chan struct{}
+	done   chan struct{}
+}
+
+func newWebhook(cfg *types.WebhookConfig, dir string, l *slog.Logger) *webhook {
+	return &webhook{
+		cfg:    cfg,
+		dir:    dir,
+		client: &http.Client{Timeout: *cfg.Timeout},
+		l:      l,
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (wh *webhook) matches(ev *Event) bool {
+	return matchAny(wh.cfg.Backends, ev.Backend) && matchAny(wh.cfg.Entities, ev.Entity) &&
+		(len(wh.cfg.Operations) == 0 || slices.Contains(wh.cfg.Operations, string(ev.Operation)))
+}
+
+// enqueue stores event in queue and wakes up worker.
+func (wh *webhook) enqueue(ev *Event) error {
+	if err := wh.save(&delivery{Event: ev}); err != nil {
+		return err
+	}
+	select {
+	case wh.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// save writes delivery into queue. File is renamed into place, so that worker never sees it partially written.
+func (wh *webhook) save(d *delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(wh.dir, d.Event.EventID+".tmp")
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(wh.dir, d.Event.EventID+".json"))
+}
+
+func (wh *webhook) run(ctx context.Context) {
+	defer close(wh.done)
+	for {
+		t := time.NewTimer(wh.drain(ctx))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-wh.wake:
+		case <-t.C:
+		}
+		t.Stop()
+	}
+}
+
+// drain attempts all deliveries that are due, in order of events. It returns time until next delivery is due.
+func (wh *webhook) drain(ctx context.Context) time.Duration {
+	wait := idleWait
+	entries, err := os.ReadDir(wh.dir)
+	if err != nil {
+		wh.l.Error("unable to read queue", "err", err)
+		return wait
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		if ctx.Err() != nil {
+			return wait
+		}
+		file := filepath.Join(wh.dir, e.Name())
+		var d delivery
+		if err = readJSON(file, &d); err != nil || d.Event == nil {
+			wh.l.Error("discarding unreadable delivery", "err", err, "file", file)
+			_ = os.Remove(file)
+			continue
+		}
+		if left := time.Until(d.Next); left > 0 {
+			wait = min(wait, left)
+			continue
+		}
+		if left := wh.attempt(ctx, file, &d); left > 0 {
+			wait = min(wait, left)
+		}
+	}
+	return wait
+}
+
+// attempt makes single attempt to deliver event. When it fails and there are attempts left,
+// delay until the next one is returned.
+func (wh *webhook) attempt(ctx context.Context, file string, d *delivery) time.Duration {
+	err := wh.post(ctx, d.Event)
+	if err == nil {
+		if err = os.Remove(file); err != nil {
+			wh.l.Error("unable to remove delivered event from queue", "err", err, "event", d.Event.EventID)
+		}
+		return 0
+	}
+	if ctx.Err() != nil {
+		// shutting down, attempt doesn't count
+		return 0
+	}
+	d.Attempts++
+	if d.Attempts >= *wh.cfg.MaxAttempts {
+		wh.l.Error("giving up delivery of event", "err", err, "event", d.Event.EventID, "attempts", d.Attempts)
+		if err = os.Rename(file, filepath.Join(wh.dir, failedDir, filepath.Base(file))); err != nil {
+			wh.l.Error("unable to move failed delivery", "err", err, "event", d.Event.EventID)
+		}
+		return 0
+	}
+	delay := wh.backoff(d.Attempts)
+	wh.l.Warn("delivery of event failed, will retry", "err", err, "event", d.Event.EventID, "attempts", d.Attempts, "delay", delay)
+	d.Next = time.Now().Add(delay)
+	if err = wh.save(d); err != nil {
+		wh.l.Error("unable to update queued event", "err", err, "event", d.Event.EventID)
+	}
+	return delay
+}
+
+// backoff gets delay after given number of failed attempts.
+func (wh *webhook) backoff(attempts int) time.Duration {
+	delay := *wh.cfg.MinBackoff
+	for i := 1; i < attempts && delay < *wh.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, *wh.cfg.MaxBackoff)
+}
+
+// post sends event to subscriber, any response other than 2xx is failure.
+func (wh *webhook) post(ctx context.Context, ev *Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, ev.EventID)
+	req.Header.Set(HeaderTimestamp, ts)
+	if wh.cfg.Secret != nil {
+		req.Header.Set(HeaderSignature, Sign(*wh.cfg.Secret, ts, body))
+	}
+	resp, err := wh.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+func readJSON(file string, v interface{}) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
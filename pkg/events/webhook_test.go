@@ -0,0 +1,223 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testWebhook(name, url string) *types.WebhookConfig {
+	return &types.WebhookConfig{
+		Name:        name,
+		URL:         url,
+		Secret:      new("s3cr3t"),
+		Backends:    []string{"*"},
+		Entities:    []string{"*"},
+		Timeout:     new(time.Second),
+		MaxAttempts: new(3),
+		MinBackoff:  new(10 * time.Millisecond),
+		MaxBackoff:  new(20 * time.Millisecond),
+	}
+}
+
+func testRecord(entity string, op audit.Operation) *audit.Record {
+	return &audit.Record{
+		Time:      time.Now().UTC(),
+		Principal: "alice",
+		Backend:   "demo",
+		Entity:    entity,
+		Operation: op,
+		ID:        "1",
+		Before:    api.UntypedDto{"name": "a"},
+		After:     api.UntypedDto{"name": "b"},
+		Row:       api.UntypedDto{"name": "b", "secret": "s"},
+	}
+}
+
+func queued(t *testing.T, dir string) int {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	assert.NoError(t, err)
+	return len(files)
+}
+
+func TestSign(t *testing.T) {
+	sig := Sign("key", "1700000000", []byte(`{"a":1}`))
+	assert.Equal(t, sig, Sign("key", "1700000000", []byte(`{"a":1}`)))
+	assert.Len(t, sig, len("sha256=")+64)
+	assert.NotEqual(t, sig, Sign("other", "1700000000", []byte(`{"a":1}`)))
+	assert.NotEqual(t, sig, Sign("key", "1700000001", []byte(`{"a":1}`)))
+}
+
+func TestWebhookMatches(t *testing.T) {
+	wc := testWebhook("test", "http://localhost")
+	wc.Entities = []string{"emp*"}
+	wc.Operations = []string{"create", "delete"}
+	wh := newWebhook(wc, t.TempDir(), slog.Default())
+	assert.True(t, wh.matches(&Event{Backend: "demo", Entity: "employees", Operation: audit.OpCreate}))
+	assert.False(t, wh.matches(&Event{Backend: "demo", Entity: "employees", Operation: audit.OpUpdate}))
+	assert.False(t, wh.matches(&Event{Backend: "demo", Entity: "dept", Operation: audit.OpDelete}))
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	wc := testWebhook("test", "http://localhost")
+	wc.MinBackoff = new(time.Second)
+	wc.MaxBackoff = new(5 * time.Second)
+	wh := newWebhook(wc, t.TempDir(), slog.Default())
+	assert.Equal(t, time.Second, wh.backoff(1))
+	assert.Equal(t, 2*time.Second, wh.backoff(2))
+	assert.Equal(t, 4*time.Second, wh.backoff(3))
+	assert.Equal(t, 5*time.Second, wh.backoff(4))
+	assert.Equal(t, 5*time.Second, wh.backoff(100))
+}
+
+func TestPublishDelivers(t *testing.T) {
+	received := make(chan *Event, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, Sign("s3cr3t", r.Header.Get(HeaderTimestamp), body), r.Header.Get(HeaderSignature))
+		var ev Event
+		assert.NoError(t, json.Unmarshal(body, &ev))
+		assert.Equal(t, ev.EventID, r.Header.Get(HeaderEventID))
+		received <- &ev
+	}))
+	defer srv.Close()
+
+	wc := testWebhook("test", srv.URL)
+	wc.Entities = []string{"emp"}
+	dir := t.TempDir()
+	p, err := New(&types.EventsConfig{QueueDir: dir, Webhooks: []*types.WebhookConfig{wc}}, slog.Default())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close())
+	}()
+	p.Publish(context.TODO(), []*audit.Record{
+		testRecord("dept", audit.OpCreate),
+		testRecord("emp", audit.OpCommand),
+		testRecord("emp", audit.OpUpdate),
+	})
+	select {
+	case ev := <-received:
+		assert.Equal(t, "demo", ev.Backend)
+		assert.Equal(t, "emp", ev.Entity)
+		assert.Equal(t, audit.OpUpdate, ev.Operation)
+		assert.Equal(t, "alice", ev.Principal)
+		assert.Equal(t, "1", ev.ID)
+		assert.Equal(t, "a", ev.Old["name"])
+		assert.Equal(t, "b", ev.New["name"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+	assert.Eventually(t, func() bool {
+		return queued(t, filepath.Join(dir, "test")) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, received)
+}
+
+func TestPublishRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	p, err := New(&types.EventsConfig{QueueDir: dir, Webhooks: []*types.WebhookConfig{testWebhook("test", srv.URL)}}, slog.Default())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close())
+	}()
+	p.Publish(context.TODO(), []*audit.Record{testRecord("emp", audit.OpDelete)})
+	assert.Eventually(t, func() bool {
+		return calls.Load() == 3 && queued(t, filepath.Join(dir, "test")) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, queued(t, filepath.Join(dir, "test", failedDir)))
+}
+
+func TestPublishGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	p, err := New(&types.EventsConfig{QueueDir: dir, Webhooks: []*types.WebhookConfig{testWebhook("test", srv.URL)}}, slog.Default())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close())
+	}()
+	p.Publish(context.TODO(), []*audit.Record{testRecord("emp", audit.OpCreate)})
+	assert.Eventually(t, func() bool {
+		return queued(t, filepath.Join(dir, "test", failedDir)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, 0, queued(t, filepath.Join(dir, "test")))
+}
+
+func TestQueueSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	wc := testWebhook("test", down.URL)
+	wc.MaxAttempts = new(100)
+	p, err := New(&types.EventsConfig{QueueDir: dir, Webhooks: []*types.WebhookConfig{wc}}, slog.Default())
+	assert.NoError(t, err)
+	p.Publish(context.TODO(), []*audit.Record{testRecord("emp", audit.OpCreate)})
+	assert.NoError(t, p.Close())
+	assert.Equal(t, 1, queued(t, filepath.Join(dir, "test")))
+
+	received := make(chan struct{}, 1)
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+	}))
+	defer up.Close()
+	p, err = New(&types.EventsConfig{QueueDir: dir, Webhooks: []*types.WebhookConfig{testWebhook("test", up.URL)}}, slog.Default())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close())
+	}()
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued event was not delivered after restart")
+	}
+	assert.Eventually(t, func() bool {
+		return queued(t, filepath.Join(dir, "test")) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = os.Stat(filepath.Join(dir, "test", failedDir))
+	assert.NoError(t, err)
+}
@@ -20,6 +20,7 @@ type builder struct {
 	ords Orders
 	fe   FilterExpression
 	pg   page
+	s    *Search
 }
 
 func (b *builder) OrderBy(name string, asc bool) Builder {
@@ -38,8 +39,13 @@ func (b *builder) Filter(fe FilterExpression) Builder {
 	return b
 }
 
+func (b *builder) Search(s *Search) Builder {
+	b.s = s
+	return b
+}
+
 func (b *builder) Build() Interface {
-	return &qryData{orders: b.ords, paging: b.pg, filter: b.fe}
+	return &qryData{orders: b.ords, paging: b.pg, filter: b.fe, search: b.s}
 }
 
 func NewBuilder() Builder {
@@ -32,6 +32,10 @@ const (
 	DefaultPageOffset = 0
 	DefaultPageSize   = 20
 	qpFilter          = "filter"
+	qpRSQL            = "q"
+	qpSearch          = "search"
+	qpSearchMode      = "search-mode"
+	qpSearchScore     = "search-score"
 	qpPageSize        = "page-size"
 	qpPageOffset      = "page-offset"
 	qpOrder           = "order[]"
@@ -59,10 +63,24 @@ func ToParams(params Interface) (*api.ListItemsParams, error) {
 		ret.PageOffset = new(int(paging.Offset()))
 		ret.PageSize = new(paging.Size())
 	}
+	if s := params.Search(); s != nil {
+		ret.Search = new(s.Text)
+		if s.Mode != "" {
+			ret.SearchMode = new(api.ListItemsParamsSearchMode(s.Mode))
+		}
+		if s.Score {
+			ret.SearchScore = new(true)
+		}
+	}
 	return ret, nil
 }
 
 func FromParams(pPageOffset *api.PageOffset, pPageSize *api.PageSize, pOrders *[]string, pFilter *string) (Interface, error) {
+	return FromParamsRSQL(pPageOffset, pPageSize, pOrders, pFilter, nil)
+}
+
+// FromParamsRSQL is like FromParams, but filter can be also given in RSQL syntax in pRSQL, exclusively with pFilter.
+func FromParamsRSQL(pPageOffset *api.PageOffset, pPageSize *api.PageSize, pOrders *[]string, pFilter *string, pRSQL *string) (Interface, error) {
 	var (
 		orders Orders
 		err    error
@@ -85,11 +103,19 @@ func FromParams(pPageOffset *api.PageOffset, pPageSize *api.PageSize, pOrders *[
 			orders = append(orders, &ord)
 		}
 	}
+	if pFilter != nil && pRSQL != nil {
+		return nil, fmt.Errorf("parameters %s and %s are mutually exclusive", qpFilter, qpRSQL)
+	}
 	if pFilter != nil {
 		if filter, err = DecodeFilter(*pFilter); err != nil {
 			return nil, err
 		}
 	}
+	if pRSQL != nil {
+		if filter, err = ParseRSQL(*pRSQL); err != nil {
+			return nil, err
+		}
+	}
 	qry := &qryData{
 		paging: Page(uint64(pageOffset), pageSize),
 		orders: orders,
@@ -98,10 +124,28 @@ func FromParams(pPageOffset *api.PageOffset, pPageSize *api.PageSize, pOrders *[
 	return qry, nil
 }
 
-func EncodeRequest(req *http.Request, qry Interface) error {
+type encodeOpts struct {
+	rsql bool
+}
+
+// EncodeOpt customizes EncodeRequest
+type EncodeOpt func(*encodeOpts)
+
+// WithRSQL makes EncodeRequest emit filter in RSQL syntax as `q` parameter, instead of JSON in `filter` parameter.
+func WithRSQL() EncodeOpt {
+	return func(o *encodeOpts) {
+		o.rsql = true
+	}
+}
+
+func EncodeRequest(req *http.Request, qry Interface, opts ...EncodeOpt) error {
 	var (
 		err error
+		eo  encodeOpts
 	)
+	for _, opt := range opts {
+		opt(&eo)
+	}
 	q := req.URL.Query()
 	if qry.Paging() != nil {
 		q.Set(qpPageOffset, strconv.FormatUint(qry.Paging().Offset(), 10))
@@ -116,13 +160,28 @@ func EncodeRequest(req *http.Request, qry Interface) error {
 			q.Add(qpOrder, string(data))
 		}
 	}
-	if qry.Filter() != nil {
+	if qry.Filter() != nil && eo.rsql {
+		var str string
+		if str, err = FormatRSQL(qry.Filter()); err != nil {
+			return err
+		}
+		q.Set(qpRSQL, str)
+	} else if qry.Filter() != nil {
 		var buff strings.Builder
 		if err = json.NewEncoder(&buff).Encode(qry.Filter()); err != nil {
 			return err
 		}
 		q.Set(qpFilter, buff.String())
 	}
+	if s := qry.Search(); s != nil {
+		q.Set(qpSearch, s.Text)
+		if s.Mode != "" {
+			q.Set(qpSearchMode, string(s.Mode))
+		}
+		if s.Score {
+			q.Set(qpSearchScore, "true")
+		}
+	}
 	if len(q) > 0 {
 		req.URL.RawQuery = q.Encode()
 	}
@@ -152,6 +211,9 @@ func decodeExprFromMap(m map[string]interface{}) FilterExpression {
 	if sub, ok = hasSubMap("between", m); ok {
 		return betweenExprFromMap(sub)
 	}
+	if sub, ok = hasSubMap("json", m); ok {
+		return jsonPathExprFromMap(sub)
+	}
 	return nil
 }
 
@@ -184,9 +246,20 @@ func simpleExprFromMap(m map[string]interface{}) FilterExpression {
 }
 
 func inExprFromMap(m map[string]interface{}) FilterExpression {
+	if op, _ := m["op"].(string); Op(op) == OpNotIn {
+		return NotIn(fmt.Sprintf("%v", m["name"]), m["val"].([]interface{}))
+	}
 	return In(fmt.Sprintf("%v", m["name"]), m["val"].([]interface{}))
 }
 
+func jsonPathExprFromMap(m map[string]interface{}) FilterExpression {
+	return JSONPath(
+		fmt.Sprintf("%v", m["name"]),
+		fmt.Sprintf("%v", m["path"]),
+		Op(m["op"].(string)),
+		fmt.Sprintf("%v", m["val"]))
+}
+
 func unExprFromMap(m map[string]interface{}) FilterExpression {
 	return UnaryExpr(fmt.Sprintf("%v", m["name"]), Op(m["op"].(string)))
 }
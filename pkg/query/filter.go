@@ -52,14 +52,36 @@ func (s simpleExpr) Value() interface{} {
 	return s.val
 }
 
+// wrapStr quotes string value using q as quote character.
+// Embedded quote characters and backslashes are escaped, so value can't break out of the literal.
 func wrapStr(v interface{}, q string) interface{} {
-	if _, ok := v.(string); ok {
-		return q + v.(string) + q
+	if s, ok := v.(string); ok {
+		if q == "'" {
+			s = strings.ReplaceAll(s, `\`, `\\`)
+		}
+		return q + strings.ReplaceAll(s, q, q+q) + q
 	}
 	return v
 }
 
+// escapeLike escapes wildcards of LIKE pattern, so that value is matched literally.
+func escapeLike(v interface{}) string {
+	return likeEscaper.Replace(fmt.Sprintf("%v", v))
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (s simpleExpr) String() string {
+	switch s.Op() {
+	case OpILike:
+		return fmt.Sprintf(`LOWER(%s) LIKE LOWER(%v)`, s.Name(), wrapStr(s.Value(), "'"))
+	case OpContains:
+		return fmt.Sprintf(`%s LIKE %v`, s.Name(), wrapStr("%"+escapeLike(s.Value())+"%", "'"))
+	case OpStartsWith:
+		return fmt.Sprintf(`%s LIKE %v`, s.Name(), wrapStr(escapeLike(s.Value())+"%", "'"))
+	case OpEndsWith:
+		return fmt.Sprintf(`%s LIKE %v`, s.Name(), wrapStr("%"+escapeLike(s.Value()), "'"))
+	}
 	return fmt.Sprintf(`%s %s %v`, s.Name(), s.Op(), wrapStr(s.Value(), "'"))
 }
 
@@ -126,17 +148,57 @@ func Not(expr FilterExpression) FilterExpression {
 	return &notExpr{expr: expr}
 }
 
+// Ge creates `name >= val` expression.
+func Ge(name string, val interface{}) FilterExpression {
+	return SimpleExpr(name, OpGe, val)
+}
+
+// Le creates `name <= val` expression.
+func Le(name string, val interface{}) FilterExpression {
+	return SimpleExpr(name, OpLe, val)
+}
+
+// ILike creates case-insensitive LIKE expression.
+func ILike(name string, pattern string) FilterExpression {
+	return SimpleExpr(name, OpILike, pattern)
+}
+
+// Regexp creates expression that matches value of column against regular expression.
+func Regexp(name string, pattern string) FilterExpression {
+	return SimpleExpr(name, OpRegexp, pattern)
+}
+
+// Contains creates expression that matches values containing given substring.
+func Contains(name string, s string) FilterExpression {
+	return SimpleExpr(name, OpContains, s)
+}
+
+// StartsWith creates expression that matches values starting with given prefix.
+func StartsWith(name string, s string) FilterExpression {
+	return SimpleExpr(name, OpStartsWith, s)
+}
+
+// EndsWith creates expression that matches values ending with given suffix.
+func EndsWith(name string, s string) FilterExpression {
+	return SimpleExpr(name, OpEndsWith, s)
+}
+
 type inExpr struct {
 	name string
+	op   Op
 	val  []interface{}
 }
 
 func (i inExpr) MarshalJSON() ([]byte, error) {
+	m := map[string]interface{}{
+		"name": i.Name(),
+		"val":  i.Values(),
+	}
+	if i.op != OpIn {
+		m["op"] = i.Op()
+	}
 	return json.Marshal(map[string]interface{}{
-		"in": map[string]interface{}{
-			"name": i.Name(),
-			"val":  i.Values(),
-		},
+		"in": m,
 	})
 }
 
@@ -144,12 +206,16 @@ func (i inExpr) Name() string {
 	return i.name
 }
 
+func (i inExpr) Op() Op {
+	return i.op
+}
+
 func (i inExpr) Values() []interface{} {
 	return i.val
 }
 
 func (i inExpr) String() string {
-	return fmt.Sprintf("%s IN (%s)", i.name, strings.Join(lo.Map(i.val, func(item interface{}, _ int) string {
+	return fmt.Sprintf("%s %s (%s)", i.name, i.op, strings.Join(lo.Map(i.val, func(item interface{}, _ int) string {
 		return fmt.Sprintf("%v", wrapStr(item, "'"))
 	}), ","))
 }
@@ -157,6 +223,16 @@ func (i inExpr) String() string {
 func In(name string, vals []interface{}) FilterExpression {
 	return &inExpr{
 		name: name,
+		op:   OpIn,
+		val:  vals,
+	}
+}
+
+// NotIn creates `name NOT IN (vals...)` expression.
+func NotIn(name string, vals []interface{}) FilterExpression {
+	return &inExpr{
+		name: name,
+		op:   OpNotIn,
 		val:  vals,
 	}
 }
@@ -228,6 +304,55 @@ func BetweenExpr(name string, left interface{}, right interface{}) FilterExpress
 	return &betweenExpr{name: name, left: left, right: right}
 }
 
+// jsonPathExpr compares value at path within JSON column.
+type jsonPathExpr struct {
+	name string
+	path string
+	op   Op
+	val  interface{}
+}
+
+func (j jsonPathExpr) Name() string {
+	return j.name
+}
+
+func (j jsonPathExpr) Path() string {
+	return j.path
+}
+
+func (j jsonPathExpr) Op() Op {
+	return j.op
+}
+
+func (j jsonPathExpr) Value() interface{} {
+	return j.val
+}
+
+func (j jsonPathExpr) String() string {
+	// ->> is shorthand for JSON_UNQUOTE(JSON_EXTRACT(...))
+	return simpleExpr{
+		name: fmt.Sprintf("%s->>%v", j.name, wrapStr(j.path, "'")),
+		op:   j.op,
+		val:  j.val,
+	}.String()
+}
+
+func (j jsonPathExpr) MarshalJSON() ([]byte, error) {
+	return json.Marshal(FilterExpressionWrapper{
+		JSONPath: &FilterJSONPathExpression{
+			Name: j.Name(),
+			Path: j.Path(),
+			Op:   string(j.Op()),
+			Val:  j.Value(),
+		},
+	})
+}
+
+// JSONPath creates expression that compares value at path within JSON column, such as `$.address.city`.
+func JSONPath(name, path string, op Op, val interface{}) FilterExpression {
+	return &jsonPathExpr{name: name, path: path, op: op, val: val}
+}
+
 type FilterBetweenExpression struct {
 	Left  interface{} `json:"left"`
 	Name  string      `json:"name"`
@@ -237,6 +362,7 @@ type FilterBetweenExpression struct {
 type FilterExpressionWrapper struct {
 	Between  *FilterBetweenExpression  `json:"between,omitempty"`
 	In       *FilterInExpression       `json:"in,omitempty"`
+	JSONPath *FilterJSONPathExpression `json:"json,omitempty"`
 	Junction *FilterJunctionExpression `json:"junction,omitempty"`
 	Not      *FilterNotExpression      `json:"not,omitempty"`
 	Simple   *FilterSimpleExpression   `json:"simple,omitempty"`
@@ -244,8 +370,17 @@ type FilterExpressionWrapper struct {
 }
 
 type FilterInExpression struct {
-	Name string        `json:"name"`
-	Val  []interface{} `json:"val"`
+	Name string `json:"name"`
+	// Op is either IN (default) or NOT IN
+	Op  string        `json:"op,omitempty"`
+	Val []interface{} `json:"val"`
+}
+
+type FilterJSONPathExpression struct {
+	Name string      `json:"name"`
+	Path string      `json:"path"`
+	Op   string      `json:"op"`
+	Val  interface{} `json:"val"`
 }
 
 type FilterJunctionExpression struct {
@@ -283,9 +418,16 @@ func (e *FilterNotExpression) AsFilterExpression() FilterExpression {
 }
 
 func (e *FilterInExpression) AsFilterExpression() FilterExpression {
+	if Op(e.Op) == OpNotIn {
+		return NotIn(e.Name, e.Val)
+	}
 	return In(e.Name, e.Val)
 }
 
+func (e *FilterJSONPathExpression) AsFilterExpression() FilterExpression {
+	return JSONPath(e.Name, e.Path, Op(e.Op), e.Val)
+}
+
 func (e *FilterBetweenExpression) AsFilterExpression() FilterExpression {
 	return BetweenExpr(e.Name, e.Left, e.Right)
 }
@@ -313,5 +455,33 @@ func (few *FilterExpressionWrapper) AsFilterExpression() FilterExpression {
 	if few.Un != nil {
 		return few.Un.AsFilterExpression()
 	}
+	if few.JSONPath != nil {
+		return few.JSONPath.AsFilterExpression()
+	}
 	return nil
 }
+
+// RenameFilter gets copy of filter expression with every column name replaced using fn.
+func RenameFilter(fe FilterExpression, fn func(string) string) FilterExpression {
+	switch e := fe.(type) {
+	case JunctionExpression:
+		sub := make([]FilterExpression, len(e.Sub()))
+		for i, s := range e.Sub() {
+			sub[i] = RenameFilter(s, fn)
+		}
+		return Junction(e.Op(), sub...)
+	case NotExpression:
+		return Not(RenameFilter(e.Sub(), fn))
+	case JSONPathExpression:
+		return JSONPath(fn(e.Name()), e.Path(), e.Op(), e.Value())
+	case SimpleExpression:
+		return SimpleExpr(fn(e.Name()), e.Op(), e.Value())
+	case InExpression:
+		return &inExpr{name: fn(e.Name()), op: e.Op(), val: e.Values()}
+	case UnaryExpression:
+		return UnaryExpr(fn(e.Name()), e.Op())
+	case BetweenExpression:
+		return BetweenExpr(fn(e.Name()), e.Left(), e.Right())
+	}
+	return fe
+}
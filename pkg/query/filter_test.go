@@ -101,6 +101,38 @@ func TestFilterExpressionWrapper(t *testing.T) {
 		assert.Equal(t, "((department IS NOT NULL) AND (age BETWEEN 70 AND 100))", fe.String())
 	})
 
+	t.Run("not in+json", func(t *testing.T) {
+		wrapper = &FilterExpressionWrapper{
+			Junction: &FilterJunctionExpression{
+				Op: string(OpOr),
+				Sub: []FilterExpressionWrapper{
+					{
+						In: &FilterInExpression{
+							Name: "dept",
+							Op:   string(OpNotIn),
+							Val:  []interface{}{"HR"},
+						},
+					},
+					{
+						JSONPath: &FilterJSONPathExpression{
+							Name: "attrs",
+							Path: "$.level",
+							Op:   string(OpGe),
+							Val:  3,
+						},
+					},
+				},
+			},
+		}
+		fe := wrapper.AsFilterExpression()
+		assert.Equal(t, "((dept NOT IN ('HR')) OR (attrs->>'$.level' >= 3))", fe.String())
+		data, err := EncodeFilter(fe)
+		assert.NoError(t, err)
+		fe, err = DecodeFilter(string(data))
+		assert.NoError(t, err)
+		assert.Equal(t, "((dept NOT IN ('HR')) OR (attrs->>'$.level' >= '3'))", fe.String())
+	})
+
 	t.Run("nil", func(t *testing.T) {
 		assert.Nil(t, (&FilterExpressionWrapper{}).AsFilterExpression())
 	})
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// member name or array index within JSON path
+var jsonPathStepRE = regexp.MustCompile(`\.(\w+)|\[(\d+)]`)
+
+// Match evaluates filter expression against item in memory, mimicking how database would evaluate its SQL.
+// Values are compared as numbers when both sides are numeric, otherwise as text. Comparison with NULL never matches.
+// LIKE and REGEXP are case-sensitive, ILIKE is not. Expression should be validated first.
+func Match(fe FilterExpression, item map[string]interface{}) (bool, error) {
+	switch e := fe.(type) {
+	case nil:
+		return false, errNilFilter
+	case JunctionExpression:
+		for _, sub := range e.Sub() {
+			ok, err := Match(sub, item)
+			if err != nil {
+				return false, err
+			}
+			if ok == (e.Op() == OpOr) {
+				return ok, nil
+			}
+		}
+		return e.Op() == OpAnd, nil
+	case NotExpression:
+		ok, err := Match(e.Sub(), item)
+		return !ok, err
+	case JSONPathExpression:
+		return matchSimple(e.Op(), jsonPathValue(item[e.Name()], e.Path()), e.Value())
+	case SimpleExpression:
+		return matchSimple(e.Op(), item[e.Name()], e.Value())
+	case InExpression:
+		v := item[e.Name()]
+		if v == nil {
+			return false, nil
+		}
+		found := slices.ContainsFunc(e.Values(), func(val interface{}) bool {
+			return val != nil && compare(v, val) == 0
+		})
+		return found == (e.Op() != OpNotIn), nil
+	case UnaryExpression:
+		return (item[e.Name()] == nil) == (e.Op() == OpIsNull), nil
+	case BetweenExpression:
+		v := item[e.Name()]
+		return v != nil && compare(v, e.Left()) >= 0 && compare(v, e.Right()) <= 0, nil
+	}
+	return false, fmt.Errorf("unsupported filter expression: %T", fe)
+}
+
+func matchSimple(op Op, v, val interface{}) (bool, error) {
+	if v == nil || val == nil {
+		return false, nil
+	}
+	s, pattern := text(v), text(val)
+	switch op {
+	case OpEq:
+		return compare(v, val) == 0, nil
+	case OpNe, OpNe2:
+		return compare(v, val) != 0, nil
+	case OpGt:
+		return compare(v, val) > 0, nil
+	case OpGe:
+		return compare(v, val) >= 0, nil
+	case OpLt:
+		return compare(v, val) < 0, nil
+	case OpLe:
+		return compare(v, val) <= 0, nil
+	case OpContains:
+		return strings.Contains(s, pattern), nil
+	case OpStartsWith:
+		return strings.HasPrefix(s, pattern), nil
+	case OpEndsWith:
+		return strings.HasSuffix(s, pattern), nil
+	case OpLike, OpNotLike, OpILike:
+		re, err := likeRegexp(pattern, op == OpILike)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(s) == (op != OpNotLike), nil
+	case OpRegexp, OpNotRegexp:
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return re.MatchString(s) == (op == OpRegexp), nil
+	}
+	return false, fmt.Errorf("unsupported operator: '%s'", op)
+}
+
+// likeRegexp translates LIKE pattern into regular expression. Wildcards can be escaped by backslash.
+func likeRegexp(pattern string, fold bool) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	if fold {
+		sb.WriteString("(?is)^")
+	} else {
+		sb.WriteString("(?s)^")
+	}
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// text gets textual form of value, as database would convert it.
+func text(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	case time.Time:
+		return t.Format(time.DateTime)
+	case bool:
+		if t {
+			return "1"
+		}
+		return "0"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// number gets numeric form of value, if it has any.
+func number(v interface{}) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case float32:
+		return float64(t), true
+	case int:
+		return float64(t), true
+	case int32:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case uint64:
+		return float64(t), true
+	case bool:
+		if t {
+			return 1, true
+		}
+		return 0, true
+	case json.Number:
+		f, err := t.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return f, err == nil
+	case []byte:
+		f, err := strconv.ParseFloat(strings.TrimSpace(string(t)), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// compare compares two non-nil values, numerically if possible.
+func compare(a, b interface{}) int {
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(text(a), text(b))
+}
+
+// jsonPathValue gets value at path within JSON document, which is either encoded or already decoded.
+// Nil is returned when there is no such value.
+func jsonPathValue(doc interface{}, path string) interface{} {
+	switch t := doc.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(t), &doc); err != nil {
+			return nil
+		}
+	case []byte:
+		if err := json.Unmarshal(t, &doc); err != nil {
+			return nil
+		}
+	}
+	for _, m := range jsonPathStepRE.FindAllStringSubmatch(path, -1) {
+		switch cur := doc.(type) {
+		case map[string]interface{}:
+			doc = cur[m[1]]
+		case []interface{}:
+			idx, err := strconv.Atoi(m[2])
+			if m[2] == "" || err != nil || idx >= len(cur) {
+				return nil
+			}
+			doc = cur[idx]
+		default:
+			return nil
+		}
+	}
+	return doc
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	item := map[string]interface{}{
+		"name":   "Bob",
+		"salary": 1500.0,
+		"dept":   "IT",
+		"note":   nil,
+		"attrs":  `{"address": {"city": "Bratislava"}, "tags": ["a", "b"]}`,
+	}
+	for in, out := range map[string]bool{
+		`name==Bob`:                           true,
+		`name!=Bob`:                           false,
+		`salary=gt=1000`:                      true,
+		`salary=le=1000`:                      false,
+		`name==Bob;salary=lt=1000,dept==IT`:   true,
+		`dept=in=(HR,IT)`:                     true,
+		`dept=out=(HR,IT)`:                    false,
+		`name=like=B%`:                        true,
+		`name=like=b%`:                        false,
+		`name=ilike=b_b`:                      true,
+		`name=notlike=%o%`:                    false,
+		`name=regex="^B.*b$"`:                 true,
+		`name=contains=o`:                     true,
+		`name=startswith=Bo;name=endswith=ob`: true,
+		`note=isnull=true`:                    true,
+		`note==x`:                             false,
+		`note!=x`:                             false,
+		`missing=isnull=true`:                 true,
+		`salary=between=(1000,2000)`:          true,
+		`attrs.address.city==Bratislava`:      true,
+		`attrs.tags[1]==b`:                    true,
+		`attrs.tags[5]==b`:                    false,
+	} {
+		t.Run(in, func(t *testing.T) {
+			fe, err := ParseRSQL(in)
+			assert.NoError(t, err)
+			ok, err := Match(fe, item)
+			assert.NoError(t, err)
+			assert.Equal(t, out, ok)
+		})
+	}
+	ok, err := Match(Not(SimpleExpr("name", OpEq, "Bob")), item)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = Match(SimpleExpr("name", OpRegexp, "("), item)
+	assert.Error(t, err)
+}
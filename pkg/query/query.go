@@ -31,12 +31,30 @@ const (
 	OpIsNull    = Op("IS NULL")
 	OpIsNotNull = Op("IS NOT NULL")
 	OpGt        = Op(">")
+	OpGe        = Op(">=")
 	OpLt        = Op("<")
+	OpLe        = Op("<=")
 	OpNe        = Op("<>")
 	OpNe2       = Op("!=")
 	OpIn        = Op("IN")
+	OpNotIn     = Op("NOT IN")
 	OpLike      = Op("LIKE")
 	OpNotLike   = Op("NOT LIKE")
+	// OpILike is case-insensitive LIKE, regardless of collation of column
+	OpILike     = Op("ILIKE")
+	OpRegexp    = Op("REGEXP")
+	OpNotRegexp = Op("NOT REGEXP")
+	// OpContains, OpStartsWith and OpEndsWith match literal substring, wildcards in value are escaped
+	OpContains   = Op("contains")
+	OpStartsWith = Op("startsWith")
+	OpEndsWith   = Op("endsWith")
+)
+
+const (
+	SearchNatural = SearchMode("natural")
+	SearchBoolean = SearchMode("boolean")
+	// ScoreColumn is pseudo-column holding relevance of item to search text, items can be ordered by it.
+	ScoreColumn = "_score"
 )
 
 var (
@@ -98,6 +116,7 @@ type qryData struct {
 	orders Orders
 	paging Paging
 	filter FilterExpression
+	search *Search
 }
 
 func (q *qryData) Orders() Orders {
@@ -112,6 +131,27 @@ func (q *qryData) Filter() FilterExpression {
 	return q.filter
 }
 
+func (q *qryData) Search() *Search {
+	return q.search
+}
+
+// WithSearch gets copy of query with full-text search added.
+func WithSearch(q Interface, s *Search) Interface {
+	return &qryData{orders: q.Orders(), paging: q.Paging(), filter: q.Filter(), search: s}
+}
+
+// Rename gets copy of query with every column referenced by filter and orders replaced using fn.
+func Rename(q Interface, fn func(string) string) Interface {
+	res := &qryData{paging: q.Paging(), search: q.Search()}
+	if q.Filter() != nil {
+		res.filter = RenameFilter(q.Filter(), fn)
+	}
+	for _, o := range q.Orders() {
+		res.orders = append(res.orders, OrderBy(fn(o.Name()), o.Asc()))
+	}
+	return res
+}
+
 func (q *qryData) String() string {
 	var sb strings.Builder
 	if q.filter != nil {
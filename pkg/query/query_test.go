@@ -48,6 +48,21 @@ func TestExpressionString(t *testing.T) {
 				SimpleExpr("salary", ">", 1200),
 				In("department", []interface{}{"HR", "management"}),
 			).String())
+		assert.Equal(t, "dept NOT IN ('HR')", NotIn("dept", []interface{}{"HR"}).String())
+	})
+
+	t.Run("operators", func(t *testing.T) {
+		assert.Equal(t, "age >= 18", Ge("age", 18).String())
+		assert.Equal(t, "age <= 65", Le("age", 65).String())
+		assert.Equal(t, "LOWER(name) LIKE LOWER('jo%')", ILike("name", "jo%").String())
+		assert.Equal(t, "name REGEXP '^J[a-z]+$'", Regexp("name", "^J[a-z]+$").String())
+		assert.Equal(t, "name LIKE '%oh%'", Contains("name", "oh").String())
+		assert.Equal(t, "name LIKE 'Jo%'", StartsWith("name", "Jo").String())
+		assert.Equal(t, `name LIKE '%\\_x'`, EndsWith("name", "_x").String())
+		assert.Equal(t, "attrs->>'$.address.city' = 'Bratislava'",
+			JSONPath("attrs", "$.address.city", OpEq, "Bratislava").String())
+		assert.Equal(t, "attrs->>'$.tags[0]' LIKE 'a%'",
+			JSONPath("attrs", "$.tags[0]", OpStartsWith, "a").String())
 	})
 }
 
@@ -91,6 +106,20 @@ func TestParseFilter(t *testing.T) {
 		assert.Equal(t, "url IS NOT NULL", fe.String())
 	})
 
+	t.Run("NOT IN expression", func(t *testing.T) {
+		fe, err = DecodeFilter(`{"in": { "name": "code", "op": "NOT IN", "val": ["x"]}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, OpNotIn, fe.(InExpression).Op())
+		assert.Equal(t, "code NOT IN ('x')", fe.String())
+	})
+
+	t.Run("JSON path", func(t *testing.T) {
+		fe, err = DecodeFilter(`{"json": {"name": "attrs", "path": "$.size", "op": ">=", "val": 10}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "$.size", fe.(JSONPathExpression).Path())
+		assert.Equal(t, "attrs->>'$.size' >= '10'", fe.String())
+	})
+
 	t.Run("BETWEEN", func(t *testing.T) {
 		fe, err = DecodeFilter(`{"between":{"left":50,"name":"age","right":60}}`)
 		assert.NoError(t, err)
@@ -129,6 +158,25 @@ func TestQueryString(t *testing.T) {
 	assert.Equal(t, " WHERE NOT (salary > 5000)", qry.String())
 }
 
+func TestRename(t *testing.T) {
+	upper := strings.ToUpper
+	qry := Rename(&qryData{
+		orders: Orders{OrderBy("name", false)},
+		paging: Page(0, 10),
+		filter: Junction(OpOr,
+			Not(SimpleExpr("name", "=", "John")),
+			In("dept", []interface{}{1, 2}),
+			NotIn("dept", []interface{}{3}),
+			UnaryExpr("note", OpIsNull),
+			BetweenExpr("age", 18, 65),
+			JSONPath("attrs", "$.color", OpEq, "red"),
+		),
+	}, upper)
+	assert.Equal(t, " WHERE ((NOT (NAME = 'John')) OR (DEPT IN (1,2)) OR (DEPT NOT IN (3)) OR (NOTE IS NULL) "+
+		"OR (AGE BETWEEN 18 AND 65) OR (ATTRS->>'$.color' = 'red')) ORDER BY `NAME` DESC LIMIT 0, 10", qry.(*qryData).String())
+	assert.Nil(t, Rename(DefaultQuery, upper).Filter())
+}
+
 func TestPage(t *testing.T) {
 	var p Paging
 	p = Page(0, 100)
@@ -259,6 +307,14 @@ func TestDecodeRequest(t *testing.T) {
 	assert.Equal(t, true, qry.Orders()[0].Asc())
 	assert.Equal(t, "age", qry.Orders()[1].Name())
 	assert.Equal(t, false, qry.Orders()[1].Asc())
+
+	qry, err = FromParamsRSQL(nil, nil, nil, nil, new("name==John"))
+	assert.NoError(t, err)
+	assert.Equal(t, "name = 'John'", qry.Filter().String())
+	_, err = FromParamsRSQL(nil, nil, nil, &s, new("name==John"))
+	assert.Error(t, err)
+	_, err = FromParamsRSQL(nil, nil, nil, nil, new("name=John"))
+	assert.Equal(t, 5, err.(*ParseError).Pos)
 }
 
 func TestDecodeExprFromMap(t *testing.T) {
@@ -286,4 +342,21 @@ func TestEncodeRequest(t *testing.T) {
 	assert.Contains(t, req.URL.RawQuery, "order%5B%5D=name")
 	assert.Contains(t, req.URL.RawQuery, "order%5B%5D=age")
 	assert.NoError(t, err)
+
+	req, _ = http.NewRequest(http.MethodGet, "", nil)
+	assert.NoError(t, EncodeRequest(req, NewBuilder().Filter(Ge("age", 30)).Build(), WithRSQL()))
+	assert.Equal(t, "age=ge=30", req.URL.Query().Get("q"))
+	assert.Empty(t, req.URL.Query().Get("filter"))
+	assert.Error(t, EncodeRequest(req, NewBuilder().Filter(Not(Ge("age", 30))).Build(), WithRSQL()))
+
+	req, _ = http.NewRequest(http.MethodGet, "", nil)
+	qry := WithSearch(NewBuilder().OrderBy(ScoreColumn, false).Build(), &Search{Text: "red shoes", Score: true})
+	assert.NoError(t, EncodeRequest(req, qry))
+	assert.Equal(t, "red shoes", req.URL.Query().Get("search"))
+	assert.Equal(t, "true", req.URL.Query().Get("search-score"))
+	assert.Empty(t, req.URL.Query().Get("search-mode"))
+	params, err := ToParams(qry)
+	assert.NoError(t, err)
+	assert.Equal(t, "red shoes", *params.Search)
+	assert.True(t, *params.SearchScore)
 }
@@ -0,0 +1,461 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// comparison operators of RSQL, mapped to operators of simple expression
+	rsqlOps = map[string]Op{
+		"==":           OpEq,
+		"!=":           OpNe2,
+		"=gt=":         OpGt,
+		">":            OpGt,
+		"=ge=":         OpGe,
+		">=":           OpGe,
+		"=lt=":         OpLt,
+		"<":            OpLt,
+		"=le=":         OpLe,
+		"<=":           OpLe,
+		"=like=":       OpLike,
+		"=notlike=":    OpNotLike,
+		"=ilike=":      OpILike,
+		"=regex=":      OpRegexp,
+		"=notregex=":   OpNotRegexp,
+		"=contains=":   OpContains,
+		"=startswith=": OpStartsWith,
+		"=endswith=":   OpEndsWith,
+	}
+	// inverse of rsqlOps, used when rendering
+	rsqlTokens = map[Op]string{
+		OpEq:         "==",
+		OpNe:         "!=",
+		OpNe2:        "!=",
+		OpGt:         "=gt=",
+		OpGe:         "=ge=",
+		OpLt:         "=lt=",
+		OpLe:         "=le=",
+		OpLike:       "=like=",
+		OpNotLike:    "=notlike=",
+		OpILike:      "=ilike=",
+		OpRegexp:     "=regex=",
+		OpNotRegexp:  "=notregex=",
+		OpContains:   "=contains=",
+		OpStartsWith: "=startswith=",
+		OpEndsWith:   "=endswith=",
+		OpIn:         "=in=",
+		OpNotIn:      "=out=",
+	}
+	// operators that NOT can be folded into when rendering
+	inverseOps = map[Op]Op{
+		OpEq:        OpNe2,
+		OpNe:        OpEq,
+		OpNe2:       OpEq,
+		OpLike:      OpNotLike,
+		OpNotLike:   OpLike,
+		OpRegexp:    OpNotRegexp,
+		OpNotRegexp: OpRegexp,
+		OpIn:        OpNotIn,
+		OpNotIn:     OpIn,
+		OpIsNull:    OpIsNotNull,
+		OpIsNotNull: OpIsNull,
+	}
+)
+
+// characters that can't appear in unquoted value
+const rsqlReserved = `"'(),;=!<> ` + "\t\r\n"
+
+// ParseError is returned when filter expression can't be parsed.
+type ParseError struct {
+	// Pos is 1-based position of character within input, where problem was found
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Pos, e.Msg)
+}
+
+type rsqlParser struct {
+	in  string
+	pos int
+}
+
+func (p *rsqlParser) errorAt(pos int, format string, args ...interface{}) error {
+	return &ParseError{
+		Pos: utf8.RuneCountInString(p.in[:pos]) + 1,
+		Msg: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *rsqlParser) peek() byte {
+	if p.pos < len(p.in) {
+		return p.in[p.pos]
+	}
+	return 0
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func (p *rsqlParser) skipSpace() {
+	for p.pos < len(p.in) && isSpace(p.in[p.pos]) {
+		p.pos++
+	}
+}
+
+// accept consumes separator of logical operator, which is either sep character or keyword surrounded by whitespace.
+func (p *rsqlParser) accept(sep byte, kw string) bool {
+	start := p.pos
+	p.skipSpace()
+	if p.peek() == sep {
+		p.pos++
+		return true
+	}
+	if end := p.pos + len(kw); p.pos > start && end < len(p.in) &&
+		strings.EqualFold(p.in[p.pos:end], kw) && isSpace(p.in[end]) {
+		p.pos = end
+		return true
+	}
+	p.pos = start
+	return false
+}
+
+func (p *rsqlParser) junction(op Op, sep byte, kw string, next func() (FilterExpression, error)) (FilterExpression, error) {
+	var sub []FilterExpression
+	for {
+		fe, err := next()
+		if err != nil {
+			return nil, err
+		}
+		sub = append(sub, fe)
+		if !p.accept(sep, kw) {
+			break
+		}
+	}
+	if len(sub) == 1 {
+		return sub[0], nil
+	}
+	return Junction(op, sub...), nil
+}
+
+// or parses `and (',' and)*`, AND binds tighter than OR
+func (p *rsqlParser) or() (FilterExpression, error) {
+	return p.junction(OpOr, ',', "or", p.and)
+}
+
+// and parses `constraint (';' constraint)*`
+func (p *rsqlParser) and() (FilterExpression, error) {
+	return p.junction(OpAnd, ';', "and", p.constraint)
+}
+
+func isSelectorChar(c byte) bool {
+	return c == '_' || c == '$' || c == '.' || c == '[' || c == ']' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// selector parses name of column, optionally followed by path within JSON column, such as `attrs.tags[0]`.
+func (p *rsqlParser) selector() (name string, path string, err error) {
+	start := p.pos
+	for p.pos < len(p.in) && isSelectorChar(p.in[p.pos]) {
+		p.pos++
+	}
+	sel := p.in[start:p.pos]
+	if sel == "" {
+		return "", "", p.errorAt(start, "expected selector")
+	}
+	name = sel
+	if i := strings.IndexAny(sel, ".["); i >= 0 {
+		name, path = sel[:i], "$"+sel[i:]
+		if !jsonPathRE.MatchString(path) {
+			return "", "", p.errorAt(start+i, "invalid JSON path: '%s'", sel[i:])
+		}
+	}
+	if ValidName(name) != nil {
+		return "", "", p.errorAt(start, "invalid selector: '%s'", name)
+	}
+	return name, path, nil
+}
+
+// comparison parses operator, either in FIQL form `=xyz=` or one of `==`, `!=`, `>`, `>=`, `<`, `<=`.
+func (p *rsqlParser) comparison() (string, error) {
+	start := p.pos
+	rest := p.in[p.pos:]
+	for _, tok := range []string{"==", "!=", ">=", "<=", ">", "<"} {
+		if strings.HasPrefix(rest, tok) {
+			p.pos += len(tok)
+			return tok, nil
+		}
+	}
+	if strings.HasPrefix(rest, "=") {
+		if end := strings.IndexByte(rest[1:], '='); end > 0 {
+			p.pos += end + 2
+			return strings.ToLower(rest[:end+2]), nil
+		}
+	}
+	return "", p.errorAt(start, "expected comparison operator")
+}
+
+func (p *rsqlParser) value() (string, error) {
+	p.skipSpace()
+	start := p.pos
+	if q := p.peek(); q == '"' || q == '\'' {
+		var sb strings.Builder
+		for p.pos++; p.pos < len(p.in); p.pos++ {
+			c := p.in[p.pos]
+			switch {
+			case c == q:
+				p.pos++
+				return sb.String(), nil
+			case c == '\\' && p.pos+1 < len(p.in):
+				p.pos++
+				sb.WriteByte(p.in[p.pos])
+			default:
+				sb.WriteByte(c)
+			}
+		}
+		return "", p.errorAt(start, "unterminated quoted value")
+	}
+	for p.pos < len(p.in) && !strings.ContainsRune(rsqlReserved, rune(p.in[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorAt(start, "expected value")
+	}
+	return p.in[start:p.pos], nil
+}
+
+// arguments parses either single value or parenthesized list of values
+func (p *rsqlParser) arguments() (args []string, list bool, err error) {
+	p.skipSpace()
+	if p.peek() != '(' {
+		v, err := p.value()
+		return []string{v}, false, err
+	}
+	p.pos++
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, true, err
+		}
+		args = append(args, v)
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return args, true, nil
+		default:
+			return nil, true, p.errorAt(p.pos, "expected ',' or ')'")
+		}
+	}
+}
+
+func (p *rsqlParser) constraint() (FilterExpression, error) {
+	p.skipSpace()
+	if p.peek() == '(' {
+		p.pos++
+		fe, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != ')' {
+			return nil, p.errorAt(p.pos, "expected ')'")
+		}
+		p.pos++
+		return fe, nil
+	}
+	name, path, err := p.selector()
+	if err != nil {
+		return nil, err
+	}
+	opPos := p.pos
+	tok, err := p.comparison()
+	if err != nil {
+		return nil, err
+	}
+	argPos := p.pos
+	args, list, err := p.arguments()
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		if op, ok := rsqlOps[tok]; ok && !list {
+			return JSONPath(name, path, op, args[0]), nil
+		}
+		return nil, p.errorAt(opPos, "operator %s can't be used with JSON path", tok)
+	}
+	switch tok {
+	case "=in=", "=out=":
+		vals := make([]interface{}, len(args))
+		for i, arg := range args {
+			vals[i] = arg
+		}
+		if tok == "=out=" {
+			return NotIn(name, vals), nil
+		}
+		return In(name, vals), nil
+	case "=between=":
+		if len(args) != 2 {
+			return nil, p.errorAt(argPos, "expected 2 values, got %d", len(args))
+		}
+		return BetweenExpr(name, args[0], args[1]), nil
+	case "=isnull=":
+		switch strings.ToLower(args[0]) {
+		case "true":
+			return UnaryExpr(name, OpIsNull), nil
+		case "false":
+			return UnaryExpr(name, OpIsNotNull), nil
+		}
+		return nil, p.errorAt(argPos, "expected true or false")
+	}
+	op, ok := rsqlOps[tok]
+	if !ok {
+		return nil, p.errorAt(opPos, "unsupported operator: %s", tok)
+	}
+	if list {
+		return nil, p.errorAt(argPos, "operator %s takes single value", tok)
+	}
+	return SimpleExpr(name, op, args[0]), nil
+}
+
+// ParseRSQL parses filter expression in RSQL syntax, such as `name==Bob;salary=gt=1000,dept=in=(IT,HR)`.
+// Logical operators are `;` (or `and`) and `,` (or `or`), AND binds tighter than OR, parentheses group constraints.
+// Selector can have JSON path appended, like `attrs.address.city==Bratislava`.
+// All values are parsed as strings, values with reserved characters must be quoted.
+func ParseRSQL(s string) (FilterExpression, error) {
+	p := &rsqlParser{in: s}
+	fe, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.in) {
+		return nil, p.errorAt(p.pos, "unexpected '%c'", p.in[p.pos])
+	}
+	return fe, nil
+}
+
+// quoteRSQL renders value, quoting it when it contains reserved characters.
+func quoteRSQL(v interface{}) string {
+	s := fmt.Sprintf("%v", v)
+	if s != "" && !strings.ContainsAny(s, rsqlReserved+`\`) {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func formatRSQL(sb *strings.Builder, fe FilterExpression) error {
+	switch e := fe.(type) {
+	case JunctionExpression:
+		sep := map[Op]string{OpAnd: ";", OpOr: ","}[e.Op()]
+		if sep == "" || len(e.Sub()) == 0 {
+			return fmt.Errorf("junction can't be expressed in RSQL: %s", fe)
+		}
+		for i, sub := range e.Sub() {
+			if i > 0 {
+				sb.WriteString(sep)
+			}
+			_, nested := sub.(JunctionExpression)
+			if nested {
+				sb.WriteByte('(')
+			}
+			if err := formatRSQL(sb, sub); err != nil {
+				return err
+			}
+			if nested {
+				sb.WriteByte(')')
+			}
+		}
+		return nil
+	case NotExpression:
+		// RSQL has no negation, so it's folded into operator when possible
+		switch s := e.Sub().(type) {
+		case NotExpression:
+			return formatRSQL(sb, s.Sub())
+		case JSONPathExpression:
+			if op, ok := inverseOps[s.Op()]; ok {
+				return formatRSQL(sb, JSONPath(s.Name(), s.Path(), op, s.Value()))
+			}
+		case SimpleExpression:
+			if op, ok := inverseOps[s.Op()]; ok {
+				return formatRSQL(sb, SimpleExpr(s.Name(), op, s.Value()))
+			}
+		case InExpression:
+			return formatRSQL(sb, &inExpr{name: s.Name(), op: inverseOps[s.Op()], val: s.Values()})
+		case UnaryExpression:
+			return formatRSQL(sb, UnaryExpr(s.Name(), inverseOps[s.Op()]))
+		}
+		return fmt.Errorf("negation can't be expressed in RSQL: %s", fe)
+	case JSONPathExpression:
+		tok, ok := rsqlTokens[e.Op()]
+		if !ok || !strings.HasPrefix(e.Path(), "$") {
+			return fmt.Errorf("expression can't be expressed in RSQL: %s", fe)
+		}
+		sb.WriteString(e.Name() + e.Path()[1:] + tok + quoteRSQL(e.Value()))
+		return nil
+	case SimpleExpression:
+		tok, ok := rsqlTokens[e.Op()]
+		if !ok {
+			return fmt.Errorf("operator can't be expressed in RSQL: %s", e.Op())
+		}
+		sb.WriteString(e.Name() + tok + quoteRSQL(e.Value()))
+		return nil
+	case InExpression:
+		tok, ok := rsqlTokens[e.Op()]
+		if !ok || len(e.Values()) == 0 {
+			return fmt.Errorf("expression can't be expressed in RSQL: %s", fe)
+		}
+		vals := make([]string, len(e.Values()))
+		for i, v := range e.Values() {
+			vals[i] = quoteRSQL(v)
+		}
+		sb.WriteString(e.Name() + tok + "(" + strings.Join(vals, ",") + ")")
+		return nil
+	case UnaryExpression:
+		switch e.Op() {
+		case OpIsNull:
+			sb.WriteString(e.Name() + "=isnull=true")
+		case OpIsNotNull:
+			sb.WriteString(e.Name() + "=isnull=false")
+		default:
+			return fmt.Errorf("operator can't be expressed in RSQL: %s", e.Op())
+		}
+		return nil
+	case BetweenExpression:
+		sb.WriteString(e.Name() + "=between=(" + quoteRSQL(e.Left()) + "," + quoteRSQL(e.Right()) + ")")
+		return nil
+	}
+	return fmt.Errorf("unsupported filter expression: %T", fe)
+}
+
+// FormatRSQL renders filter expression in RSQL syntax accepted by ParseRSQL.
+// Not every expression can be rendered, as RSQL has no negation of arbitrary expression.
+func FormatRSQL(fe FilterExpression) (string, error) {
+	var sb strings.Builder
+	if err := formatRSQL(&sb, fe); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
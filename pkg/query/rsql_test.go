@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRSQL(t *testing.T) {
+	for in, out := range map[string]string{
+		`name==Bob`: `name = 'Bob'`,
+		`name==Bob;salary=gt=1000,department=in=(IT,HR)`: `((((name = 'Bob') AND (salary > '1000'))) OR (department IN ('IT','HR')))`,
+		`name==Bob;(salary>1000,department=out=(IT))`:    `((name = 'Bob') AND (((salary > '1000') OR (department NOT IN ('IT')))))`,
+		`name==Bob and age<=30 or age>=60`:               `((((name = 'Bob') AND (age <= '30'))) OR (age >= '60'))`,
+		`name=="Bob, Jr." ; note!=x`:                     `((name = 'Bob, Jr.') AND (note != 'x'))`,
+		`note=='it\'s'`:                                  `note = 'it''s'`,
+		`name=ILIKE=bo%`:                                 `LOWER(name) LIKE LOWER('bo%')`,
+		`name=contains=100%`:                             `name LIKE '%100\\%%'`,
+		`name=regex="^B.*b$"`:                            `name REGEXP '^B.*b$'`,
+		`url=isnull=true;email=isnull=false`:             `((url IS NULL) AND (email IS NOT NULL))`,
+		`age=between=(18,65)`:                            `age BETWEEN '18' AND '65'`,
+		`attrs.address.city==Bratislava`:                 `attrs->>'$.address.city' = 'Bratislava'`,
+		`attrs.tags[0]=startswith=a`:                     `attrs->>'$.tags[0]' LIKE 'a%'`,
+	} {
+		t.Run(in, func(t *testing.T) {
+			fe, err := ParseRSQL(in)
+			assert.NoError(t, err)
+			if err == nil {
+				assert.Equal(t, out, fe.String())
+				assert.NoError(t, Validate(fe))
+			}
+		})
+	}
+}
+
+func TestParseRSQLErrors(t *testing.T) {
+	for in, pos := range map[string]int{
+		``:                       1,
+		`name`:                   5,
+		`name=Bob`:               5,
+		`name==`:                 7,
+		`name==Bob;`:             11,
+		`name==Bob)`:             10,
+		`(name==Bob`:             11,
+		`name=foo=1`:             5,
+		`name==(a,b)`:            7,
+		`name=in=(a b)`:          12,
+		`name=="Bob`:             7,
+		`age=between=(1)`:        13,
+		`url=isnull=maybe`:       12,
+		`attrs.a[x]==1`:          6,
+		`attrs.a=in=(1)`:         8,
+		`name==Bob;ž==1`:         11,
+		`näme==1`:                2,
+		`name==Bob;age=gt=1 xyz`: 20,
+	} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseRSQL(in)
+			if assert.Error(t, err) {
+				assert.Equal(t, pos, err.(*ParseError).Pos, err.Error())
+			}
+		})
+	}
+}
+
+func TestFormatRSQL(t *testing.T) {
+	for _, fe := range []FilterExpression{
+		SimpleExpr("name", OpEq, "Bob"),
+		Junction(OpOr,
+			Junction(OpAnd, SimpleExpr("name", OpEq, "Bob, Jr."), Ge("salary", 1000)),
+			In("department", []interface{}{"IT", "R&D (new)"}),
+		),
+		Junction(OpAnd, Contains("note", `say "hi"`), Regexp("name", `^\w+$`)),
+		Not(In("dept", []interface{}{"HR"})),
+		Not(UnaryExpr("url", OpIsNull)),
+		Not(SimpleExpr("name", OpLike, "B%")),
+		BetweenExpr("age", 18, 65),
+		JSONPath("attrs", "$.tags[0]", OpEndsWith, "x"),
+		SimpleExpr("name", OpEq, ""),
+	} {
+		t.Run(fe.String(), func(t *testing.T) {
+			str, err := FormatRSQL(fe)
+			assert.NoError(t, err)
+			parsed, err := ParseRSQL(str)
+			assert.NoError(t, err, str)
+			if err == nil {
+				expected, _ := FormatRSQL(fe)
+				actual, _ := FormatRSQL(parsed)
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+	str, err := FormatRSQL(Junction(OpOr, Junction(OpAnd, SimpleExpr("a", OpEq, 1), SimpleExpr("b", OpNe, 2)), In("c", []interface{}{3})))
+	assert.NoError(t, err)
+	assert.Equal(t, "(a==1;b!=2),c=in=(3)", str)
+
+	_, err = FormatRSQL(Not(Ge("age", 1)))
+	assert.Error(t, err)
+	_, err = FormatRSQL(Junction(OpAnd))
+	assert.Error(t, err)
+	_, err = FormatRSQL(UnaryExpr("a", OpEq))
+	assert.Error(t, err)
+}
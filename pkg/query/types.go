@@ -42,17 +42,34 @@ type Interface interface {
 	Orders() Orders
 	Paging() Paging
 	Filter() FilterExpression
+	// Search gets full-text search, or nil if there is none
+	Search() *Search
 }
 
 type Builder interface {
 	OrderBy(string, bool) Builder
 	Paging(int, int) Builder
 	Filter(FilterExpression) Builder
+	Search(*Search) Builder
 	Build() Interface
 }
 
+type SearchMode string
+
+// Search is full-text search within columns of entity that are covered by full-text index.
+// It's combined with filter, so items must satisfy both.
+type Search struct {
+	Text string
+	// Mode of search, empty means mode configured for entity
+	Mode SearchMode
+	// Score adds relevance of item to search text as ScoreColumn
+	Score bool
+}
+
+// InExpression <prop> IN (...) / <prop> NOT IN (...)
 type InExpression interface {
 	Name() string
+	Op() Op
 	Values() []interface{}
 }
 
@@ -82,3 +99,9 @@ type SimpleExpression interface {
 	Op() Op
 	Value() interface{}
 }
+
+// JSONPathExpression compares value at path within JSON column, using operators of SimpleExpression.
+type JSONPathExpression interface {
+	SimpleExpression
+	Path() string
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+)
+
+var (
+	identRE = regexp.MustCompile(`^[\w$]{1,64}$`)
+	// member names and array indexes, wildcards are not allowed as they yield multiple values
+	jsonPathRE = regexp.MustCompile(`^\$(\.\w+|\[\d+])*$`)
+	simpleOps  = []Op{OpEq, OpGt, OpGe, OpLt, OpLe, OpNe, OpNe2, OpLike, OpNotLike, OpILike,
+		OpRegexp, OpNotRegexp, OpContains, OpStartsWith, OpEndsWith}
+	junctionOps  = []Op{OpAnd, OpOr}
+	unaryOps     = []Op{OpIsNull, OpIsNotNull}
+	inOps        = []Op{OpIn, OpNotIn}
+	errNilFilter = fmt.Errorf("invalid filter expression")
+)
+
+// ValidName checks that name can be safely used as column name.
+func ValidName(name string) error {
+	if !identRE.MatchString(name) {
+		return fmt.Errorf("invalid column name: '%s'", name)
+	}
+	return nil
+}
+
+// validValue checks that value is scalar, as only scalars are rendered into SQL as literals.
+func validValue(v interface{}) error {
+	if n, ok := v.(json.Number); ok {
+		if _, err := n.Float64(); err != nil {
+			return fmt.Errorf("invalid number: '%s'", v)
+		}
+		return nil
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil
+	}
+	return fmt.Errorf("unsupported value: %T, only strings, numbers and booleans are allowed", v)
+}
+
+func validOp(op Op, allowed []Op) error {
+	if !slices.Contains(allowed, op) {
+		return fmt.Errorf("unsupported operator: '%s'", op)
+	}
+	return nil
+}
+
+// Validate checks that all column names and operators within filter expression are valid,
+// so that expression can be safely rendered into SQL.
+func Validate(fe FilterExpression) error {
+	switch e := fe.(type) {
+	case nil:
+		return errNilFilter
+	case JunctionExpression:
+		if err := validOp(e.Op(), junctionOps); err != nil {
+			return err
+		}
+		for _, sub := range e.Sub() {
+			if err := Validate(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	case NotExpression:
+		return Validate(e.Sub())
+	case JSONPathExpression:
+		if err := validOp(e.Op(), simpleOps); err != nil {
+			return err
+		}
+		if !jsonPathRE.MatchString(e.Path()) {
+			return fmt.Errorf("invalid JSON path: '%s'", e.Path())
+		}
+		if err := validValue(e.Value()); err != nil {
+			return err
+		}
+		return ValidName(e.Name())
+	case SimpleExpression:
+		if err := validOp(e.Op(), simpleOps); err != nil {
+			return err
+		}
+		if err := validValue(e.Value()); err != nil {
+			return err
+		}
+		return ValidName(e.Name())
+	case InExpression:
+		if err := validOp(e.Op(), inOps); err != nil {
+			return err
+		}
+		for _, v := range e.Values() {
+			if err := validValue(v); err != nil {
+				return err
+			}
+		}
+		return ValidName(e.Name())
+	case UnaryExpression:
+		if err := validOp(e.Op(), unaryOps); err != nil {
+			return err
+		}
+		return ValidName(e.Name())
+	case BetweenExpression:
+		if err := validValue(e.Left()); err != nil {
+			return err
+		}
+		if err := validValue(e.Right()); err != nil {
+			return err
+		}
+		return ValidName(e.Name())
+	}
+	return fmt.Errorf("unsupported filter expression: %T", fe)
+}
+
+// ValidateQuery checks filter and all orders of query.
+func ValidateQuery(q Interface) error {
+	if q.Filter() != nil {
+		if err := Validate(q.Filter()); err != nil {
+			return err
+		}
+	}
+	for _, o := range q.Orders() {
+		if err := ValidName(o.Name()); err != nil {
+			return err
+		}
+	}
+	if s := q.Search(); s != nil {
+		if len(s.Text) == 0 {
+			return fmt.Errorf("search text can't be empty")
+		}
+		if s.Mode != "" && s.Mode != SearchNatural && s.Mode != SearchBoolean {
+			return fmt.Errorf("unsupported search mode: '%s'", s.Mode)
+		}
+	}
+	return nil
+}
+
+func filterColumns(fe FilterExpression, res []string) []string {
+	switch e := fe.(type) {
+	case JunctionExpression:
+		for _, sub := range e.Sub() {
+			res = filterColumns(sub, res)
+		}
+	case NotExpression:
+		res = filterColumns(e.Sub(), res)
+	case SimpleExpression:
+		res = append(res, e.Name())
+	case InExpression:
+		res = append(res, e.Name())
+	case UnaryExpression:
+		res = append(res, e.Name())
+	case BetweenExpression:
+		res = append(res, e.Name())
+	}
+	return res
+}
+
+// Columns gets names of all columns referenced by filter and orders of query, without duplicates.
+func Columns(q Interface) []string {
+	var res []string
+	if q.Filter() != nil {
+		res = filterColumns(q.Filter(), res)
+	}
+	for _, o := range q.Orders() {
+		res = append(res, o.Name())
+	}
+	slices.Sort(res)
+	return slices.Compact(res)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(Junction(OpAnd,
+		SimpleExpr("age", OpGt, 30),
+		Not(In("dept", []interface{}{"HR"})),
+		UnaryExpr("url", OpIsNull),
+		BetweenExpr("salary", 1, 2),
+	)))
+	assert.Error(t, Validate(SimpleExpr("1=1) OR (1", OpEq, 1)))
+	assert.Error(t, Validate(SimpleExpr("age", "= 1 OR 1 =", 1)))
+	assert.Error(t, Validate(Junction("; DROP TABLE x", SimpleExpr("a", OpEq, 1))))
+	assert.Error(t, Validate(UnaryExpr("url", OpEq)))
+	assert.NoError(t, Validate(Junction(OpOr,
+		Ge("age", 30), Le("age", 40), NotIn("dept", []interface{}{"HR"}),
+		ILike("name", "a%"), Regexp("name", "^A"), Contains("name", "x"),
+		JSONPath("attrs", "$.tags[0].name", OpEq, "x"),
+	)))
+	assert.Error(t, Validate(&inExpr{name: "dept", op: OpEq}))
+	assert.Error(t, Validate(JSONPath("attrs", "$.a' OR 1=1 -- ", OpEq, 1)))
+	assert.Error(t, Validate(JSONPath("attrs", "$[*]", OpEq, 1)))
+	assert.Error(t, Validate(JSONPath("attrs", "$.a", OpIsNull, 1)))
+	assert.Error(t, Validate(Junction(OpAnd, nil)))
+	// only scalars are rendered as literals
+	assert.Error(t, Validate(SimpleExpr("name", OpEq, map[string]interface{}{"x": "' OR 1=1 -- "})))
+	assert.Error(t, Validate(SimpleExpr("name", OpEq, []interface{}{"' OR 1=1 -- "})))
+	assert.Error(t, Validate(SimpleExpr("name", OpEq, nil)))
+	assert.Error(t, Validate(JSONPath("attrs", "$.a", OpEq, map[string]interface{}{})))
+	assert.Error(t, Validate(In("dept", []interface{}{"HR", []interface{}{"x"}})))
+	assert.Error(t, Validate(BetweenExpr("age", 1, map[string]interface{}{})))
+	assert.Error(t, Validate(SimpleExpr("age", OpEq, json.Number("1 OR 1=1"))))
+	assert.NoError(t, Validate(SimpleExpr("age", OpEq, json.Number("1.5"))))
+	assert.NoError(t, Validate(SimpleExpr("active", OpEq, true)))
+	assert.Error(t, ValidateQuery(NewBuilder().OrderBy("name`; --", true).Build()))
+	assert.NoError(t, ValidateQuery(NewBuilder().OrderBy("name", true).Build()))
+	assert.NoError(t, ValidateQuery(NewBuilder().Search(&Search{Text: "shoes", Mode: SearchBoolean}).Build()))
+	assert.Error(t, ValidateQuery(NewBuilder().Search(&Search{}).Build()))
+	assert.Error(t, ValidateQuery(NewBuilder().Search(&Search{Text: "shoes", Mode: "fuzzy"}).Build()))
+}
+
+func TestEscapeLiteral(t *testing.T) {
+	assert.Equal(t, `name = 'x'') OR 1=1 -- '`, SimpleExpr("name", OpEq, "x') OR 1=1 -- ").String())
+	assert.Equal(t, `name = 'a\\'''`, SimpleExpr("name", OpEq, `a\'`).String())
+	assert.Equal(t, "`we``ird` ASC", Orders{OrderBy("we`ird", true)}.String())
+	// wildcards are escaped for LIKE and then backslashes are escaped for string literal
+	assert.Equal(t, `name LIKE '%100\\%\\_\\\\%'`, Contains("name", `100%_\`).String())
+}
+
+func TestColumns(t *testing.T) {
+	q := NewBuilder().Filter(Junction(OpAnd,
+		SimpleExpr("age", OpGt, 30),
+		Not(In("dept", []interface{}{"HR"})),
+		Junction(OpOr, UnaryExpr("url", OpIsNull), BetweenExpr("age", 1, 2)),
+	)).OrderBy("name", true).OrderBy("dept", false).Build()
+	assert.Equal(t, []string{"age", "dept", "name", "url"}, Columns(q))
+	assert.Empty(t, Columns(DefaultQuery))
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+// label value used for limits that are not specific to any backend
+const globalScope = "*"
+
+// idle buckets are purged at most this often
+const bucketPurgeInterval = time.Minute
+
+var (
+	rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "db2rest",
+		Name:      "rate_limited_requests_total",
+		Help:      "Total number of requests rejected by rate limit.",
+	}, []string{"backend"})
+	inFlightRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "db2rest",
+		Name:      "in_flight_requests",
+		Help:      "Number of requests currently processed by backend.",
+	}, []string{"backend"})
+	queuedRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "db2rest",
+		Name:      "queued_requests",
+		Help:      "Number of requests waiting for free slot of backend.",
+	}, []string{"backend"})
+	shedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "db2rest",
+		Name:      "shed_requests_total",
+		Help:      "Total number of requests rejected because backend was at capacity.",
+	}, []string{"backend"})
+)
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is token-bucket rate limiter with separate bucket for every client.
+type rateLimiter struct {
+	scope string
+	cfg   *types.RateLimitConfig
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPurge time.Time
+}
+
+func newRateLimiter(scope string, cfg *types.RateLimitConfig) *rateLimiter {
+	if cfg == nil {
+		return nil
+	}
+	return &rateLimiter{
+		scope:   scope,
+		cfg:     cfg,
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+	}
+}
+
+// clientKey identifies client of request according to configuration.
+func (rl *rateLimiter) clientKey(r *http.Request) string {
+	if *rl.cfg.Key == types.RateLimitKeyPrincipal {
+		if p := auth.FromContext(r.Context()); p != nil {
+			return "principal:" + p.Name
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// purge removes buckets that were refilled completely, they are equivalent to missing ones.
+func (rl *rateLimiter) purge(now time.Time) {
+	if now.Sub(rl.lastPurge) < bucketPurgeInterval {
+		return
+	}
+	rl.lastPurge = now
+	full := time.Duration(float64(*rl.cfg.Burst) / rl.cfg.RequestsPerSecond * float64(time.Second))
+	for k, b := range rl.buckets {
+		if now.Sub(b.last) > full {
+			delete(rl.buckets, k)
+		}
+	}
+}
+
+// allow takes token from bucket of given client. If there is none, then it returns how long to wait for next one.
+func (rl *rateLimiter) allow(key string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	now := rl.now()
+	rl.purge(now)
+	burst := float64(*rl.cfg.Burst)
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		rl.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rl.cfg.RequestsPerSecond)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / rl.cfg.RequestsPerSecond * float64(time.Second))
+}
+
+// check returns false and writes 429 response, when request exceeds rate limit.
+// Nil limiter allows everything.
+func (rl *rateLimiter) check(w http.ResponseWriter, r *http.Request) bool {
+	if rl == nil {
+		return true
+	}
+	key := rl.clientKey(r)
+	ok, wait := rl.allow(key)
+	if ok {
+		return true
+	}
+	rateLimitedRequests.WithLabelValues(rl.scope).Inc()
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	out.SendWithStatus(w, types.NewErrorWithStatus(fmt.Sprintf("rate limit exceeded for %s", key),
+		http.StatusTooManyRequests), http.StatusTooManyRequests)
+	return false
+}
+
+func (rl *rateLimiter) middleware() api.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if rl.check(w, r) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// concurrencyLimiter caps number of requests in flight, requests over capacity wait for free slot up to queue timeout.
+type concurrencyLimiter struct {
+	scope   string
+	timeout time.Duration
+	slots   chan struct{}
+}
+
+func newConcurrencyLimiter(scope string, cfg *types.ConcurrencyConfig) *concurrencyLimiter {
+	if cfg == nil {
+		return nil
+	}
+	return &concurrencyLimiter{
+		scope:   scope,
+		timeout: *cfg.QueueTimeout,
+		slots:   make(chan struct{}, cfg.MaxInFlight),
+	}
+}
+
+// acquire waits for free slot. When it returns true, caller must call release.
+// Otherwise, 503 response was written already.
+// Nil limiter allows everything.
+func (cl *concurrencyLimiter) acquire(w http.ResponseWriter, r *http.Request) bool {
+	if cl == nil {
+		return true
+	}
+	select {
+	case cl.slots <- struct{}{}:
+		inFlightRequests.WithLabelValues(cl.scope).Inc()
+		return true
+	default:
+	}
+	queued := queuedRequests.WithLabelValues(cl.scope)
+	queued.Inc()
+	defer queued.Dec()
+	t := time.NewTimer(cl.timeout)
+	defer t.Stop()
+	select {
+	case cl.slots <- struct{}{}:
+		inFlightRequests.WithLabelValues(cl.scope).Inc()
+		return true
+	case <-t.C:
+	case <-r.Context().Done():
+	}
+	shedRequests.WithLabelValues(cl.scope).Inc()
+	w.Header().Set("Retry-After", "1")
+	out.SendWithStatus(w, types.NewErrorWithStatus(fmt.Sprintf("backend %s is at capacity", cl.scope),
+		http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+	return false
+}
+
+func (cl *concurrencyLimiter) release() {
+	if cl == nil {
+		return
+	}
+	<-cl.slots
+	inFlightRequests.WithLabelValues(cl.scope).Dec()
+}
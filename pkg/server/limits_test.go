@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	cfg := &types.RateLimitConfig{
+		RequestsPerSecond: 2,
+		Burst:             new(2),
+		Key:               new(types.RateLimitKeyPrincipal),
+	}
+	now := time.Now()
+	rl := newRateLimiter("demo", cfg)
+	rl.now = func() time.Time { return now }
+
+	req := func(principal string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		if principal != "" {
+			r = r.WithContext(auth.NewContext(r.Context(), &auth.Principal{Name: principal}))
+		}
+		return r
+	}
+	check := func(r *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		if rl.check(w, r) {
+			w.WriteHeader(http.StatusOK)
+		}
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, check(req("alice")).Code)
+	assert.Equal(t, http.StatusOK, check(req("alice")).Code)
+	w := check(req("alice"))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	// other principal and anonymous client have own buckets
+	assert.Equal(t, http.StatusOK, check(req("bob")).Code)
+	assert.Equal(t, http.StatusOK, check(req("")).Code)
+	assert.Equal(t, "ip:10.0.0.1", rl.clientKey(req("")))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, http.StatusOK, check(req("alice")).Code)
+	assert.Equal(t, http.StatusTooManyRequests, check(req("alice")).Code)
+
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, http.StatusOK, check(req("bob")).Code)
+	assert.Len(t, rl.buckets, 1)
+
+	var none *rateLimiter
+	assert.True(t, none.check(nil, nil))
+}
+
+func TestGlobalRateLimitByPrincipal(t *testing.T) {
+	ac := &types.AuthConfig{
+		APIKeys:        map[string]string{"alice": "key-a", "bob": "key-b"},
+		APIKeyHeader:   new("X-API-Key"),
+		AllowAnonymous: new(false),
+	}
+	rs := &restServer{
+		l: slog.Default(),
+		cfg: &types.Config{
+			Auth: ac,
+			RateLimit: &types.RateLimitConfig{
+				RequestsPerSecond: 0.001,
+				Burst:             new(1),
+				Key:               new(types.RateLimitKeyPrincipal),
+			},
+		},
+	}
+	authn, err := auth.New(ac)
+	assert.NoError(t, err)
+	h := api.HandlerWithOptions(rs, api.GorillaServerOptions{
+		BaseRouter:  mux.NewRouter(),
+		Middlewares: rs.middlewares(authn),
+	})
+	call := func(key string) int {
+		r := httptest.NewRequest(http.MethodGet, "/version", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		r.Header.Set("X-API-Key", key)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, call("key-a"))
+	assert.Equal(t, http.StatusTooManyRequests, call("key-a"))
+	// same IP, but different principal
+	assert.Equal(t, http.StatusOK, call("key-b"))
+	assert.Equal(t, http.StatusUnauthorized, call("invalid"))
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	cl := newConcurrencyLimiter("demo", &types.ConcurrencyConfig{
+		MaxInFlight:  1,
+		QueueTimeout: new(50 * time.Millisecond),
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.True(t, cl.acquire(httptest.NewRecorder(), r))
+
+	w := httptest.NewRecorder()
+	assert.False(t, cl.acquire(w, r))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cl.release()
+	}()
+	assert.True(t, cl.acquire(httptest.NewRecorder(), r))
+	cl.release()
+}
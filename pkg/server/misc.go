@@ -17,40 +17,120 @@ limitations under the License.
 package server
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/prometheus/common/version"
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
 	"github.com/rkosegi/db2rest-bridge/pkg/crud"
 	capi "github.com/rkosegi/go-http-commons/api"
+	"github.com/samber/lo"
 )
 
+// prefix of query parameters that carry named parameters of named query
+const namedParamPrefix = "p."
+
 func (rs *restServer) handleBackend(writer http.ResponseWriter, request *http.Request, backend string, handler BackendHandler) {
 	var (
 		c  crud.Interface
 		ok bool
 	)
-	if c, ok = rs.crudMap[backend]; !ok {
+	if c, ok = rs.crudMap[backend]; !ok || !rs.authz.CanSeeBackend(auth.FromContext(request.Context()), backend) {
 		http.Error(writer, fmt.Sprintf("no such backend: %s", backend), http.StatusBadRequest)
 		return
 	}
+	if !rs.rateLimiters[backend].check(writer, request) {
+		return
+	}
+	cl := rs.concLimiters[backend]
+	if !cl.acquire(writer, request) {
+		return
+	}
+	defer cl.release()
 
 	handler(c, writer, request)
 }
 
-func (rs *restServer) handleEntity(writer http.ResponseWriter, request *http.Request, backend, entity string, handler EntityHandler) {
+func (rs *restServer) handleEntity(writer http.ResponseWriter, request *http.Request, backend, entity string, op auth.Operation, handler EntityHandler) {
 	rs.handleBackend(writer, request, backend, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
+		if rs.cfg.Backends[backend].Shadowed(entity) {
+			http.Error(writer, fmt.Sprintf("no such entity: %s", entity), http.StatusNotFound)
+			return
+		}
+		if err := rs.authorize(request, op, backend, entity); err != nil {
+			out.SendWithStatus(writer, err, http.StatusForbidden)
+			return
+		}
 		handler(c, entity, writer, request)
 	})
 }
 
-func (rs *restServer) handleItem(writer http.ResponseWriter, request *http.Request, backend, entity, item string, handler ItemHandler) {
-	rs.handleEntity(writer, request, backend, entity, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
+func (rs *restServer) handleItem(writer http.ResponseWriter, request *http.Request, backend, entity, item string, op auth.Operation, handler ItemHandler) {
+	rs.handleEntity(writer, request, backend, entity, op, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
 		handler(c, entity, item, writer, request)
 	})
 }
 
+// handleNamed handles request to named query or command, op is either auth.OpQuery or auth.OpCommand.
+func (rs *restServer) handleNamed(writer http.ResponseWriter, request *http.Request, backend, name string, op auth.Operation, handler BackendHandler) {
+	rs.handleBackend(writer, request, backend, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
+		if err := rs.authorize(request, op, backend, name); err != nil {
+			out.SendWithStatus(writer, err, http.StatusForbidden)
+			return
+		}
+		handler(c, writer, request)
+	})
+}
+
+// withDeleted gets context in which soft-deleted items are visible as requested.
+func withDeleted(ctx context.Context, include, only *bool) (context.Context, error) {
+	switch {
+	case lo.FromPtr(include) && lo.FromPtr(only):
+		return nil, errors.New("include_deleted and only_deleted can't be used at once")
+	case lo.FromPtr(include):
+		return crud.WithDeleted(ctx, crud.IncludeDeleted), nil
+	case lo.FromPtr(only):
+		return crud.WithDeleted(ctx, crud.OnlyDeleted), nil
+	}
+	return ctx, nil
+}
+
+// authorize checks that principal associated with request may perform operation on resource.
+func (rs *restServer) authorize(request *http.Request, op auth.Operation, backend, resource string) error {
+	if err := rs.authz.Authorize(auth.FromContext(request.Context()), op, backend, resource); err != nil {
+		rs.l.Warn("access denied", "backend", backend, "resource", resource, "op", op, "reason", err)
+		return err
+	}
+	return nil
+}
+
+// authMiddleware authenticates every API request and stores principal into request context.
+func (rs *restServer) authMiddleware(authn auth.Authenticator) api.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, err := authn.Authenticate(r)
+			if err == nil && p == nil && !*rs.cfg.Auth.AllowAnonymous {
+				err = auth.ErrNoCredentials
+			}
+			if err != nil {
+				rs.l.Debug("authentication failed", "path", r.URL.Path, "error", err)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="db2rest"`)
+				out.SendWithStatus(w, err, http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), p)))
+		})
+	}
+}
+
 func (rs *restServer) GetVersionInfo(w http.ResponseWriter, _ *http.Request) {
 	out.SendWithStatus(w, &capi.SystemVersionInfo{
 		BuildTime: &version.BuildDate,
@@ -60,6 +140,36 @@ func (rs *restServer) GetVersionInfo(w http.ResponseWriter, _ *http.Request) {
 	}, http.StatusOK)
 }
 
+// namedArgs collects named query parameters, passed as p.<name>=<value>.
+func namedArgs(values url.Values) []interface{} {
+	var args []interface{}
+	for _, k := range slices.Sorted(maps.Keys(values)) {
+		if name, ok := strings.CutPrefix(k, namedParamPrefix); ok {
+			args = append(args, sql.Named(name, values[k]))
+		}
+	}
+	return args
+}
+
+// bodyArgs converts JSON object into named parameters of command.
+// Values are passed in their textual form, arrays as list of such values. Null values are passed as nil.
+func bodyArgs(body api.UntypedDto) []interface{} {
+	var args []interface{}
+	for _, k := range slices.Sorted(maps.Keys(body)) {
+		switch v := body[k].(type) {
+		case nil:
+			args = append(args, sql.Named(k, nil))
+		case []interface{}:
+			args = append(args, sql.Named(k, lo.Map(v, func(item interface{}, _ int) string {
+				return fmt.Sprintf("%v", item)
+			})))
+		default:
+			args = append(args, sql.Named(k, []string{fmt.Sprintf("%v", v)}))
+		}
+	}
+	return args
+}
+
 func extractIds(objs []api.UntypedDto, idCol string) ([]interface{}, error) {
 	var ids []interface{}
 	for _, obj := range objs {
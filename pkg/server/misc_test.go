@@ -17,6 +17,11 @@ limitations under the License.
 package server
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
@@ -44,3 +49,40 @@ func TestExtractIds(t *testing.T) {
 	}, "id")
 	assert.Error(t, err)
 }
+
+func TestNamedArgs(t *testing.T) {
+	assert.Equal(t, []interface{}{
+		sql.Named("depts", []string{"1", "2"}),
+		sql.Named("since", []string{"2024-01-01"}),
+	}, namedArgs(url.Values{
+		"p.since":     {"2024-01-01"},
+		"p.depts":     {"1", "2"},
+		"page-offset": {"10"},
+	}))
+}
+
+func TestBodyArgs(t *testing.T) {
+	body := make(api.UntypedDto)
+	dec := json.NewDecoder(strings.NewReader(`{"amount":12.50,"ids":[1,2],"note":"x","flag":true,"none":null}`))
+	dec.UseNumber()
+	assert.NoError(t, dec.Decode(&body))
+	assert.Equal(t, []interface{}{
+		sql.Named("amount", []string{"12.50"}),
+		sql.Named("flag", []string{"true"}),
+		sql.Named("ids", []string{"1", "2"}),
+		sql.Named("none", nil),
+		sql.Named("note", []string{"x"}),
+	}, bodyArgs(body))
+}
+
+func TestWithDeleted(t *testing.T) {
+	ctx := context.Background()
+	res, err := withDeleted(ctx, nil, new(false))
+	assert.NoError(t, err)
+	assert.Equal(t, ctx, res)
+	res, err = withDeleted(ctx, new(true), nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, ctx, res)
+	_, err = withDeleted(ctx, new(true), new(true))
+	assert.Error(t, err)
+}
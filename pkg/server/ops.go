@@ -17,19 +17,35 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"slices"
+	"time"
 
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
 	"github.com/rkosegi/db2rest-bridge/pkg/crud"
 	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/samber/lo"
 )
 
-func (rs *restServer) ListBackends(w http.ResponseWriter, _ *http.Request) {
-	bes := lo.Keys(rs.cfg.Backends)
+// bulkMode2op maps mode of bulk request to operation, which caller must be allowed to perform in addition to auth.OpBulk
+var bulkMode2op = map[api.BulkUpdateMode]auth.Operation{
+	api.INSERT:  auth.OpCreate,
+	api.REPLACE: auth.OpCreate,
+	api.UPDATE:  auth.OpUpdate,
+	api.DELETE:  auth.OpDelete,
+}
+
+func (rs *restServer) ListBackends(w http.ResponseWriter, r *http.Request) {
+	p := auth.FromContext(r.Context())
+	bes := lo.Filter(lo.Keys(rs.cfg.Backends), func(be string, _ int) bool {
+		return rs.authz.CanSeeBackend(p, be)
+	})
 	slices.Sort(bes)
 	out.SendWithStatus(w, bes, http.StatusOK)
 }
@@ -39,6 +55,10 @@ func (rs *restServer) ListEntities(w http.ResponseWriter, r *http.Request, backe
 		if entities, err := c.ListEntities(r.Context()); err != nil {
 			out.SendWithStatus(writer, err, http.StatusInternalServerError)
 		} else {
+			p := auth.FromContext(r.Context())
+			entities = lo.Filter(entities, func(entity string, _ int) bool {
+				return rs.authz.CanSeeEntity(p, backend, entity)
+			})
 			slices.Sort(entities)
 			out.SendWithStatus(writer, entities, http.StatusOK)
 		}
@@ -46,17 +66,29 @@ func (rs *restServer) ListEntities(w http.ResponseWriter, r *http.Request, backe
 }
 
 func (rs *restServer) ListItems(w http.ResponseWriter, r *http.Request, backend string, entity string, params api.ListItemsParams) {
-	rs.handleEntity(w, r, backend, entity, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
+	rs.handleEntity(w, r, backend, entity, auth.OpList, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
 		var (
 			err error
 			qry query.Interface
 			res *api.PagedResult
+			ctx context.Context
 		)
-		if qry, err = query.FromParams(params.PageOffset, params.PageSize, params.Order, params.Filter); err != nil {
+		if ctx, err = withDeleted(r.Context(), params.IncludeDeleted, params.OnlyDeleted); err != nil {
+			out.SendWithStatus(writer, err, http.StatusBadRequest)
+			return
+		}
+		if qry, err = query.FromParamsRSQL(params.PageOffset, params.PageSize, params.Order, params.Filter, params.Q); err != nil {
 			out.SendWithStatus(writer, err, http.StatusBadRequest)
 			return
 		}
-		if res, err = c.ListItems(r.Context(), entity, qry); err != nil {
+		if params.Search != nil {
+			qry = query.WithSearch(qry, &query.Search{
+				Text:  *params.Search,
+				Mode:  query.SearchMode(lo.FromPtr(params.SearchMode)),
+				Score: lo.FromPtr(params.SearchScore),
+			})
+		}
+		if res, err = c.ListItems(ctx, entity, qry); err != nil {
 			out.SendWithStatus(writer, err, http.StatusInternalServerError)
 			return
 		}
@@ -64,8 +96,18 @@ func (rs *restServer) ListItems(w http.ResponseWriter, r *http.Request, backend
 	})
 }
 
+func (rs *restServer) GetEntitySchema(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity) {
+	rs.handleEntity(w, r, backend, entity, auth.OpList, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
+		if schema, err := c.DescribeEntity(request.Context(), entity); err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+		} else {
+			out.SendWithStatus(writer, schema, http.StatusOK)
+		}
+	})
+}
+
 func (rs *restServer) CreateItem(w http.ResponseWriter, r *http.Request, backend string, entity string) {
-	rs.handleEntity(w, r, backend, entity, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
+	rs.handleEntity(w, r, backend, entity, auth.OpCreate, func(c crud.Interface, entity string, writer http.ResponseWriter, request *http.Request) {
 		var err error
 		body := make(api.UntypedDto)
 		if err = json.NewDecoder(request.Body).Decode(&body); err != nil {
@@ -82,13 +124,18 @@ func (rs *restServer) CreateItem(w http.ResponseWriter, r *http.Request, backend
 	})
 }
 
-func (rs *restServer) GetItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string) {
-	rs.handleItem(w, r, backend, entity, id, func(c crud.Interface, entity, id string, writer http.ResponseWriter, _ *http.Request) {
+func (rs *restServer) GetItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string, params api.GetItemByIdParams) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpGet, func(c crud.Interface, entity, id string, writer http.ResponseWriter, _ *http.Request) {
 		var (
 			obj api.UntypedDto
 			err error
+			ctx context.Context
 		)
-		if obj, err = c.Get(r.Context(), entity, id); err != nil {
+		if ctx, err = withDeleted(r.Context(), params.IncludeDeleted, params.OnlyDeleted); err != nil {
+			out.SendWithStatus(writer, err, http.StatusBadRequest)
+			return
+		}
+		if obj, err = c.Get(ctx, entity, id); err != nil {
 			out.SendWithStatus(writer, err, http.StatusInternalServerError)
 			return
 		}
@@ -103,9 +150,14 @@ func (rs *restServer) GetItemById(w http.ResponseWriter, r *http.Request, backen
 	})
 }
 
-func (rs *restServer) ExistsItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string) {
-	rs.handleItem(w, r, backend, entity, id, func(c crud.Interface, entity, id string, writer http.ResponseWriter, _ *http.Request) {
-		if exists, err := c.Exists(r.Context(), entity, id); err != nil {
+func (rs *restServer) ExistsItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string, params api.ExistsItemByIdParams) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpGet, func(c crud.Interface, entity, id string, writer http.ResponseWriter, _ *http.Request) {
+		ctx, err := withDeleted(r.Context(), params.IncludeDeleted, params.OnlyDeleted)
+		if err != nil {
+			out.SendWithStatus(writer, err, http.StatusBadRequest)
+			return
+		}
+		if exists, err := c.Exists(ctx, entity, id); err != nil {
 			out.SendWithStatus(writer, err, http.StatusInternalServerError)
 		} else {
 			if exists {
@@ -118,7 +170,7 @@ func (rs *restServer) ExistsItemById(w http.ResponseWriter, r *http.Request, bac
 }
 
 func (rs *restServer) UpdateItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string) {
-	rs.handleItem(w, r, backend, entity, id, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpUpdate, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
 		var (
 			err    error
 			exists bool
@@ -146,9 +198,57 @@ func (rs *restServer) UpdateItemById(w http.ResponseWriter, r *http.Request, bac
 	})
 }
 
-func (rs *restServer) DeleteItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string) {
-	rs.handleItem(w, r, backend, entity, id, func(c crud.Interface, entity, id string, writer http.ResponseWriter, _ *http.Request) {
-		if err := c.Delete(r.Context(), entity, id); err != nil {
+func (rs *restServer) DeleteItemById(w http.ResponseWriter, r *http.Request, backend string, entity string, id string, params api.DeleteItemByIdParams) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpDelete, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
+		var err error
+		if lo.FromPtr(params.Purge) {
+			if err = rs.authorize(req, auth.OpPurge, backend, entity); err != nil {
+				out.SendWithStatus(writer, err, http.StatusForbidden)
+				return
+			}
+			err = c.Purge(r.Context(), entity, id)
+		} else {
+			err = c.Delete(r.Context(), entity, id)
+		}
+		if err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+		} else {
+			writer.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func (rs *restServer) RestoreItem(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity, id string) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpUpdate, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
+		if obj, err := c.Restore(req.Context(), entity, id); err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+		} else {
+			out.SendWithStatus(writer, obj, http.StatusOK)
+		}
+	})
+}
+
+func (rs *restServer) GetBlob(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity, id string, column string) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpGet, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
+		blob, err := c.OpenBlob(req.Context(), entity, id, column)
+		if err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+			return
+		}
+		defer func() {
+			_ = blob.Close()
+		}()
+		if blob.ContentType != "" {
+			writer.Header().Set("Content-Type", blob.ContentType)
+		}
+		// takes care of Range requests, Content-Length and sniffing of Content-Type
+		http.ServeContent(writer, req, "", time.Time{}, blob)
+	})
+}
+
+func (rs *restServer) PutBlob(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity, id string, column string) {
+	rs.handleItem(w, r, backend, entity, id, auth.OpUpdate, func(c crud.Interface, entity, id string, writer http.ResponseWriter, req *http.Request) {
+		if err := c.WriteBlob(req.Context(), entity, id, column, req.Header.Get("Content-Type"), req.Body); err != nil {
 			out.SendWithStatus(writer, err, http.StatusInternalServerError)
 		} else {
 			writer.WriteHeader(http.StatusNoContent)
@@ -157,7 +257,7 @@ func (rs *restServer) DeleteItemById(w http.ResponseWriter, r *http.Request, bac
 }
 
 func (rs *restServer) BulkUpdate(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity) {
-	rs.handleEntity(w, r, backend, entity, func(c crud.Interface, entity string, writer http.ResponseWriter, req *http.Request) {
+	rs.handleEntity(w, r, backend, entity, auth.OpBulk, func(c crud.Interface, entity string, writer http.ResponseWriter, req *http.Request) {
 		var (
 			err  error
 			body api.BulkUpdateRequest
@@ -167,7 +267,11 @@ func (rs *restServer) BulkUpdate(w http.ResponseWriter, r *http.Request, backend
 			out.SendWithStatus(writer, err, http.StatusBadRequest)
 			return
 		}
-		idCol := rs.cfg.Backends[backend].IdColumn(entity)
+		if err = rs.authorize(req, bulkMode2op[body.Mode], backend, entity); err != nil {
+			out.SendWithStatus(writer, err, http.StatusForbidden)
+			return
+		}
+		idCol := rs.cfg.Backends[backend].IdField(entity)
 		switch body.Mode {
 		case api.DELETE:
 			if ids, err = extractIds(body.Objects, idCol); err == nil {
@@ -186,8 +290,34 @@ func (rs *restServer) BulkUpdate(w http.ResponseWriter, r *http.Request, backend
 	})
 }
 
-func (rs *restServer) QueryNamed(w http.ResponseWriter, r *http.Request, backend api.Backend, name string, params api.QueryNamedParams) {
+func (rs *restServer) ListQueries(w http.ResponseWriter, r *http.Request, backend api.Backend) {
 	rs.handleBackend(w, r, backend, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
+		queries, err := c.DescribeQueries(request.Context())
+		if err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+			return
+		}
+		p := auth.FromContext(request.Context())
+		// queries that caller can't run are not disclosed
+		queries = lo.Filter(queries, func(qi api.QueryInfo, _ int) bool {
+			return rs.authz.Authorize(p, auth.OpQuery, backend, qi.Name) == nil
+		})
+		for i := range queries {
+			queries[i].Permissions.Execute = true
+		}
+		out.SendWithStatus(writer, queries, http.StatusOK)
+	})
+}
+
+func (rs *restServer) FlushCache(w http.ResponseWriter, r *http.Request, backend api.Backend) {
+	rs.handleNamed(w, r, backend, "cache", auth.OpAdmin, func(c crud.Interface, writer http.ResponseWriter, _ *http.Request) {
+		c.FlushCache()
+		writer.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (rs *restServer) QueryNamed(w http.ResponseWriter, r *http.Request, backend api.Backend, name string, params api.QueryNamedParams) {
+	rs.handleNamed(w, r, backend, name, auth.OpQuery, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
 		var (
 			res *api.PagedResult
 			err error
@@ -198,8 +328,9 @@ func (rs *restServer) QueryNamed(w http.ResponseWriter, r *http.Request, backend
 				args = append(args, arg)
 			}
 		}
+		args = append(args, namedArgs(request.URL.Query())...)
 		var qry query.Interface
-		if qry, err = query.FromParams(params.PageOffset, params.PageSize, nil, nil); err != nil {
+		if qry, err = query.FromParamsRSQL(params.PageOffset, params.PageSize, params.Order, params.Filter, params.Q); err != nil {
 			out.SendWithStatus(writer, err, http.StatusBadRequest)
 			return
 		}
@@ -211,3 +342,27 @@ func (rs *restServer) QueryNamed(w http.ResponseWriter, r *http.Request, backend
 		}
 	})
 }
+
+func (rs *restServer) ExecCommand(w http.ResponseWriter, r *http.Request, backend api.Backend, name string) {
+	rs.handleNamed(w, r, backend, name, auth.OpCommand, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
+		var (
+			res *api.CommandResult
+			err error
+		)
+		body := make(api.UntypedDto)
+		if request.ContentLength != 0 {
+			dec := json.NewDecoder(request.Body)
+			dec.UseNumber()
+			if err = dec.Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+				out.SendWithStatus(writer, err, http.StatusBadRequest)
+				return
+			}
+		}
+		if res, err = c.ExecCommand(request.Context(), name, bodyArgs(body)...); err != nil {
+			rs.l.Error("can't execute command", "backend", backend, "command", name, "error", err)
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+		} else {
+			out.SendWithStatus(writer, res, http.StatusOK)
+		}
+	})
+}
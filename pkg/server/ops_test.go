@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// commandCrud knows single command and records arguments it was executed with
+type commandCrud struct {
+	crud.Interface
+	args []interface{}
+}
+
+func (c *commandCrud) ExecCommand(_ context.Context, name string, args ...interface{}) (*api.CommandResult, error) {
+	if name != "archive" {
+		return nil, types.NewErrorWithStatus("no such command: "+name, http.StatusNotFound)
+	}
+	c.args = args
+	return &api.CommandResult{AffectedRows: 2}, nil
+}
+
+func TestExecCommand(t *testing.T) {
+	c := &commandCrud{}
+	rs := &restServer{
+		l:       slog.Default(),
+		cfg:     &types.Config{},
+		crudMap: crud.NameToCrudMap{"demo": c},
+	}
+	exec := func(name, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		rs.ExecCommand(rec, httptest.NewRequest(http.MethodPost, "/demo/_command/"+name, strings.NewReader(body)), "demo", name)
+		return rec
+	}
+
+	rec := exec("archive", `{"id":7,"ids":[1,2],"note":null}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res api.CommandResult
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, 2, res.AffectedRows)
+	assert.Equal(t, []interface{}{
+		sql.Named("id", []string{"7"}),
+		sql.Named("ids", []string{"1", "2"}),
+		sql.Named("note", nil),
+	}, c.args)
+
+	// body is optional
+	assert.Equal(t, http.StatusOK, exec("archive", "").Code)
+	assert.Empty(t, c.args)
+
+	assert.Equal(t, http.StatusBadRequest, exec("archive", `{"id":`).Code)
+	assert.Equal(t, http.StatusNotFound, exec("purge_all", `{}`).Code)
+}
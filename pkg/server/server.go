@@ -18,6 +18,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -33,7 +34,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
 	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/events"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
 	"github.com/rkosegi/db2rest-bridge/pkg/types"
 	"github.com/rkosegi/go-http-commons/middlewares"
 	"github.com/rkosegi/go-http-commons/openapi"
@@ -49,6 +54,9 @@ var (
 		// foreign key constraints
 		1451: http.StatusConflict,
 		1452: http.StatusConflict,
+		// statement timeout, MySQL and MariaDB respectively
+		3024: http.StatusGatewayTimeout,
+		1969: http.StatusGatewayTimeout,
 	}
 	httpDuration = promauto.NewSummaryVec(prometheus.SummaryOpts{
 		Namespace: "db2rest",
@@ -78,6 +86,26 @@ var (
 				_, _ = w.Write([]byte(me.Message))
 				return true
 			}
+			if ve, ok := errors.AsType[*types.ValidationError](err); ok {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_ = json.NewEncoder(w).Encode(&api.ErrorObject{
+					Message: ve.Error(),
+					Code:    new(http.StatusUnprocessableEntity),
+					Data:    &map[string]interface{}{"errors": ve.Errors},
+				})
+				return true
+			}
+			if pe, ok := errors.AsType[*query.ParseError](err); ok {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&api.ErrorObject{
+					Message: pe.Error(),
+					Code:    new(http.StatusBadRequest),
+					Data:    &map[string]interface{}{"position": pe.Pos},
+				})
+				return true
+			}
 			if be, ok := errors.AsType[*types.ErrorWithStatus](err); ok {
 				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 				if be.Status != 0 {
@@ -96,24 +124,69 @@ type restServer struct {
 	server  *http.Server
 	crudMap crud.NameToCrudMap
 	l       *slog.Logger
+	authz   *auth.Authorizer
+	auditor *audit.Auditor
+	// publisher of change events, nil when disabled
+	publisher *events.Publisher
+	// per-backend limiters, missing entry means no limit
+	rateLimiters map[string]*rateLimiter
+	concLimiters map[string]*concurrencyLimiter
 }
 
 func (rs *restServer) Close() error {
-	return rs.crudMap.Close()
+	auditErr := rs.auditor.Close()
+	return errors.Join(rs.crudMap.Close(), auditErr, rs.publisher.Close())
+}
+
+// middlewares builds common middleware chain for API handlers.
+// Middlewares are applied in order, so the last one is outermost.
+func (rs *restServer) middlewares(authn auth.Authenticator) []api.MiddlewareFunc {
+	mws := []api.MiddlewareFunc{middlewares.NewLoggingBuilder().WithLogger(rs.l).Build()}
+	if authn != nil {
+		// authentication goes inside logging, so that rejected requests are still logged.
+		mws = append([]api.MiddlewareFunc{rs.authMiddleware(authn)}, mws...)
+	}
+	if rs.cfg.RateLimit != nil {
+		// innermost, so it runs after authentication and limit can be keyed by principal
+		mws = append([]api.MiddlewareFunc{newRateLimiter(globalScope, rs.cfg.RateLimit).middleware()}, mws...)
+	}
+	return mws
 }
 
 func (rs *restServer) Run(ctx context.Context) (err error) {
+	if rs.auditor, err = audit.New(rs.cfg.Audit, rs.l.With("component", "audit")); err != nil {
+		return err
+	}
+	if rs.publisher, err = events.New(rs.cfg.Events, rs.l.With("component", "events")); err != nil {
+		return err
+	}
 	rs.crudMap = make(crud.NameToCrudMap)
+	rs.rateLimiters = make(map[string]*rateLimiter)
+	rs.concLimiters = make(map[string]*concurrencyLimiter)
 	for n, be := range rs.cfg.Backends {
 		rs.l.Debug("Opening backend", "name", n)
 		if err = be.Open(ctx); err != nil {
 			rs.l.Error("Unable to open backend", "backend", n)
 			return err
 		}
-		rs.crudMap[n] = crud.New(be, rs.l.With("name", n))
+		rs.crudMap[n] = crud.New(be, rs.l.With("name", n), crud.WithName(n), crud.WithAuditor(rs.auditor),
+			crud.WithPublisher(rs.publisher))
+		rs.rateLimiters[n] = newRateLimiter(n, be.RateLimit)
+		rs.concLimiters[n] = newConcurrencyLimiter(n, be.Concurrency)
+	}
+	var authn auth.Authenticator
+	if authn, err = auth.New(rs.cfg.Auth); err != nil {
+		return err
+	}
+	mws := rs.middlewares(authn)
+	if rs.authz, err = auth.NewAuthorizer(rs.cfg.Authorization); err != nil {
+		return err
 	}
-	mws := []api.MiddlewareFunc{middlewares.NewLoggingBuilder().WithLogger(rs.l).Build()}
 
+	allowedHeaders := []string{"Content-Type"}
+	if rs.cfg.Auth != nil {
+		allowedHeaders = append(allowedHeaders, "Authorization", *rs.cfg.Auth.APIKeyHeader)
+	}
 	cors := handlers.CORS(
 		handlers.AllowedMethods([]string{
 			http.MethodHead,
@@ -124,7 +197,7 @@ func (rs *restServer) Run(ctx context.Context) (err error) {
 		}),
 		handlers.AllowedOrigins(rs.cfg.Server.Cors.AllowedOrigins),
 		handlers.MaxAge(rs.cfg.Server.Cors.MaxAge),
-		handlers.AllowedHeaders([]string{"Content-Type"}),
+		handlers.AllowedHeaders(allowedHeaders),
 	)
 
 	r := mux.NewRouter()
@@ -162,11 +235,28 @@ func (rs *restServer) Run(ctx context.Context) (err error) {
 	rs.l.DebugContext(ctx, "starting server", "listen-address", rs.cfg.Server.ListenAddress,
 		"api-prefix", rs.cfg.Server.APIPrefix)
 
-	return rs.cfg.Server.RunUntil(&http.Server{
+	// must be outermost, so that change stream gets response writer of server
+	mws = append(mws, keepRawWriter)
+
+	var spec http.Handler = http.HandlerFunc(rs.specHandler)
+	for _, mw := range mws {
+		spec = mw(spec)
+	}
+	r.Handle("/spec/{backend}/openapi.json", spec)
+
+	srv := &http.Server{
 		Handler: cors(api.HandlerWithOptions(rs, api.GorillaServerOptions{
 			BaseURL:     rs.cfg.Server.APIPrefix,
 			BaseRouter:  r,
 			Middlewares: mws,
 		})),
-	}, ctx.Done())
+	}
+	if rs.cfg.TLS != nil {
+		var tr *tlsReloader
+		if tr, err = newTlsReloader(rs.cfg.TLS, rs.l); err != nil {
+			return err
+		}
+		return serveTLS(ctx, &rs.cfg.Server, srv, tr)
+	}
+	return rs.cfg.Server.RunUntil(srv, ctx.Done())
 }
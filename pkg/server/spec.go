@@ -0,0 +1,376 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+)
+
+type obj = map[string]interface{}
+
+var (
+	paramType2JSONSchema = map[string]obj{
+		types.ParamInt:     {"type": "integer"},
+		types.ParamDecimal: {"type": "number"},
+		types.ParamString:  {"type": "string"},
+		types.ParamDate:    {"type": "string", "format": "date"},
+		types.ParamBool:    {"type": "boolean"},
+	}
+	errorResponse = obj{
+		"description": "Error",
+		"content":     jsonContent(obj{"$ref": "#/components/schemas/ErrorObject"}),
+	}
+	specParams = obj{
+		"page-offset": obj{"name": "page-offset", "in": "query", "schema": obj{"type": "integer", "minimum": 0}},
+		"page-size":   obj{"name": "page-size", "in": "query", "schema": obj{"type": "integer", "minimum": 1, "maximum": 100}},
+		"order": obj{"name": "order[]", "in": "query", "description": "Order instructions in form of key=direction",
+			"schema": obj{"type": "array", "items": obj{"type": "string"}}},
+		"filter": obj{"name": "filter", "in": "query", "description": "JSON-encoded FilterExpression",
+			"schema": obj{"type": "string"}},
+		"id": obj{"name": "id", "in": "path", "required": true, "schema": obj{"type": "string"}},
+		"search": obj{"name": "search", "in": "query", "description": "Full-text search, combined with filter",
+			"schema": obj{"type": "string", "minLength": 1}},
+		"search-mode": obj{"name": "search-mode", "in": "query",
+			"schema": obj{"type": "string", "enum": []string{"natural", "boolean"}}},
+		"search-score": obj{"name": "search-score", "in": "query", "description": "Return relevance of items in _score property",
+			"schema": obj{"type": "boolean"}},
+		"include-deleted": obj{"name": "include_deleted", "in": "query", "description": "Include soft-deleted items",
+			"schema": obj{"type": "boolean"}},
+		"only-deleted": obj{"name": "only_deleted", "in": "query", "description": "Show only soft-deleted items",
+			"schema": obj{"type": "boolean"}},
+	}
+	listParams = []interface{}{
+		obj{"$ref": "#/components/parameters/page-offset"},
+		obj{"$ref": "#/components/parameters/page-size"},
+		obj{"$ref": "#/components/parameters/order"},
+		obj{"$ref": "#/components/parameters/filter"},
+	}
+)
+
+func jsonContent(schema obj) obj {
+	return obj{"application/json": obj{"schema": schema}}
+}
+
+func ref(name string) obj {
+	return obj{"$ref": "#/components/schemas/" + name}
+}
+
+func pageSchema(item obj) obj {
+	return obj{
+		"type": "object",
+		"properties": obj{
+			"data":        obj{"type": "array", "items": item},
+			"total_count": obj{"type": "integer"},
+			"offset":      obj{"type": "number"},
+		},
+	}
+}
+
+func operation(id, summary string, responses obj) obj {
+	responses["default"] = errorResponse
+	return obj{"operationId": id, "summary": summary, "responses": responses}
+}
+
+// paramSchema gets JSON schema of named query parameter.
+func paramSchema(pi *api.QueryParamInfo) obj {
+	typ := pi.Type
+	if typ == types.ParamList {
+		typ = *pi.ItemType
+	}
+	res := obj{}
+	for k, v := range paramType2JSONSchema[typ] {
+		res[k] = v
+	}
+	if pi.Pattern != nil {
+		res["pattern"] = *pi.Pattern
+	}
+	if pi.Enum != nil {
+		res["enum"] = *pi.Enum
+	}
+	minKey, maxKey := "minimum", "maximum"
+	if typ == types.ParamString {
+		minKey, maxKey = "minLength", "maxLength"
+	}
+	if pi.Type == types.ParamList {
+		minKey, maxKey = "minItems", "maxItems"
+		res = obj{"type": "array", "items": res}
+	}
+	if pi.Min != nil {
+		res[minKey] = *pi.Min
+	}
+	if pi.Max != nil {
+		res[maxKey] = *pi.Max
+	}
+	if pi.Default != nil {
+		res["default"] = paramDefault(pi.Type, *pi.Default)
+	}
+	return res
+}
+
+// paramDefault converts default value from request format to JSON value.
+func paramDefault(typ, def string) interface{} {
+	switch typ {
+	case types.ParamInt, types.ParamDecimal:
+		if f, err := strconv.ParseFloat(def, 64); err == nil {
+			return f
+		}
+	case types.ParamBool:
+		if b, err := strconv.ParseBool(def); err == nil {
+			return b
+		}
+	case types.ParamList:
+		return strings.Split(def, ",")
+	}
+	return def
+}
+
+// specBuilder generates OpenAPI document of single backend, limited to what principal is allowed to do.
+type specBuilder struct {
+	rs      *restServer
+	backend string
+	be      *types.BackendConfig
+	p       *auth.Principal
+	paths   obj
+	schemas obj
+}
+
+func (sb *specBuilder) allowed(flag *bool, op auth.Operation, resource string) bool {
+	return *flag && sb.rs.authz.Authorize(sb.p, op, sb.backend, resource) == nil
+}
+
+func (sb *specBuilder) addEntity(es *api.EntitySchema) {
+	e := es.Name
+	sb.schemas[e] = crud.EntityJSONSchema(es, false)
+	sb.schemas[e+".create"] = obj{"allOf": []interface{}{ref(e)}}
+	if req := crud.EntityJSONSchema(es, true)["required"]; req != nil {
+		sb.schemas[e+".create"].(obj)["required"] = req
+	}
+	sb.schemas[e+".page"] = pageSchema(ref(e))
+	ec := sb.be.Entity(e)
+	// virtual entities are read-only and their items are addressable only when ID column is present
+	virtual := ec.Virtual()
+	writable := func(flag *bool, op auth.Operation) bool {
+		return !virtual && sb.allowed(flag, op, e)
+	}
+	addressable := !virtual || slices.ContainsFunc(es.Columns, func(sc api.SchemaColumn) bool {
+		return sc.Name == es.IdColumn
+	})
+
+	ok := func(desc string, schema obj) obj {
+		return obj{"description": desc, "content": jsonContent(schema)}
+	}
+	coll, item := obj{}, obj{"parameters": []interface{}{obj{"$ref": "#/components/parameters/id"}}}
+	var deletedParams []interface{}
+	if ec != nil && ec.SoftDelete != nil {
+		deletedParams = []interface{}{
+			obj{"$ref": "#/components/parameters/include-deleted"},
+			obj{"$ref": "#/components/parameters/only-deleted"},
+		}
+	}
+	if sb.allowed(sb.be.Read, auth.OpList, e) {
+		op := operation("list_"+e, "List "+e+" items", obj{"200": ok("List of items", ref(e+".page"))})
+		op["parameters"] = append(slices.Clone(listParams), deletedParams...)
+		if ec != nil && ec.Search != nil {
+			op["parameters"] = append(op["parameters"].([]interface{}),
+				obj{"$ref": "#/components/parameters/search"},
+				obj{"$ref": "#/components/parameters/search-mode"},
+				obj{"$ref": "#/components/parameters/search-score"},
+			)
+		}
+		coll["get"] = op
+	}
+	if writable(sb.be.Create, auth.OpCreate) {
+		op := operation("create_"+e, "Create "+e+" item", obj{"201": ok("Created item", ref(e))})
+		op["requestBody"] = obj{"required": true, "content": jsonContent(ref(e + ".create"))}
+		coll["post"] = op
+	}
+	if addressable && sb.allowed(sb.be.Read, auth.OpGet, e) {
+		item["get"] = operation("get_"+e, "Get "+e+" item by ID", obj{"200": ok("Item", ref(e))})
+		item["head"] = operation("exists_"+e, "Check for existence of "+e+" item by ID",
+			obj{"204": obj{"description": "Item exists"}})
+		if deletedParams != nil {
+			item["get"].(obj)["parameters"] = deletedParams
+			item["head"].(obj)["parameters"] = deletedParams
+		}
+	}
+	if writable(sb.be.Update, auth.OpUpdate) {
+		op := operation("update_"+e, "Update "+e+" item by ID", obj{"202": ok("Updated item", ref(e))})
+		op["requestBody"] = obj{"required": true, "content": jsonContent(ref(e))}
+		item["put"] = op
+		if deletedParams != nil {
+			sb.paths["/"+e+"/{id}/_restore"] = obj{
+				"parameters": []interface{}{obj{"$ref": "#/components/parameters/id"}},
+				"post":       operation("restore_"+e, "Restore soft-deleted "+e+" item by ID", obj{"200": ok("Restored item", ref(e))}),
+			}
+		}
+	}
+	if writable(sb.be.Delete, auth.OpDelete) {
+		item["delete"] = operation("delete_"+e, "Delete "+e+" item by ID", obj{"204": obj{"description": "Item was removed"}})
+		if deletedParams != nil && sb.allowed(sb.be.Delete, auth.OpPurge, e) {
+			item["delete"].(obj)["parameters"] = []interface{}{obj{"name": "purge", "in": "query",
+				"description": "Remove item physically", "schema": obj{"type": "boolean"}}}
+		}
+	}
+	flags := map[auth.Operation]*bool{auth.OpCreate: sb.be.Create, auth.OpUpdate: sb.be.Update, auth.OpDelete: sb.be.Delete}
+	var modes []string
+	for mode, op := range bulkMode2op {
+		if writable(flags[op], op) && writable(flags[op], auth.OpBulk) {
+			modes = append(modes, string(mode))
+		}
+	}
+	if len(modes) > 0 {
+		slices.Sort(modes)
+		op := operation("bulk_"+e, "Perform bulk update of "+e+" items", obj{"200": obj{"description": "Bulk operation completed"}})
+		op["requestBody"] = obj{"required": true, "content": jsonContent(obj{
+			"type":     "object",
+			"required": []string{"mode", "objects"},
+			"properties": obj{
+				"mode":    obj{"type": "string", "enum": modes},
+				"objects": obj{"type": "array", "minItems": 1, "items": ref(e)},
+			},
+		})}
+		sb.paths["/"+e+"/bulk"] = obj{"post": op}
+	}
+	if !virtual && sb.rs.publisher.Stream() != nil && sb.allowed(sb.be.Read, auth.OpList, e) {
+		op := operation("stream_"+e, "Stream changes of "+e+" items", obj{"200": obj{
+			"description": "Server-Sent Events, or WebSocket messages when upgrade is requested",
+			"content":     obj{"text/event-stream": obj{"schema": obj{"type": "string"}}},
+		}})
+		op["parameters"] = []interface{}{
+			obj{"$ref": "#/components/parameters/filter"},
+			obj{"name": "Last-Event-ID", "in": "header", "description": "Resume after event with this ID",
+				"schema": obj{"type": "string"}},
+			obj{"name": "since", "in": "query", "description": "Alternative to Last-Event-ID header",
+				"schema": obj{"type": "string"}},
+		}
+		sb.paths["/"+e+"/_events"] = obj{"get": op}
+	}
+	if len(coll) > 0 {
+		sb.paths["/"+e] = coll
+	}
+	if len(item) > 1 {
+		sb.paths["/"+e+"/{id}"] = item
+	}
+}
+
+func (sb *specBuilder) addQuery(qi *api.QueryInfo) {
+	params := slices.Clone(listParams)
+	for i := range qi.Params {
+		pi := &qi.Params[i]
+		params = append(params, obj{"name": "p." + pi.Name, "in": "query", "required": pi.Required,
+			"schema": paramSchema(pi), "explode": true})
+	}
+	row := obj{"type": "object"}
+	if qi.Columns != nil {
+		props := obj{}
+		for _, ci := range *qi.Columns {
+			col := &api.SchemaColumn{Name: ci.Name, Type: strings.TrimPrefix(ci.Type, "UNSIGNED ")}
+			if ci.Nullable != nil {
+				col.Nullable = *ci.Nullable
+			}
+			props[ci.Name] = crud.ColumnJSONSchema(col)
+		}
+		row["properties"] = props
+	}
+	sb.schemas["query."+qi.Name] = row
+	sb.schemas["query."+qi.Name+".page"] = pageSchema(ref("query." + qi.Name))
+	op := operation("query_"+qi.Name, "Execute named query "+qi.Name, obj{"200": obj{
+		"description": "Result of query",
+		"content":     jsonContent(ref("query." + qi.Name + ".page")),
+	}})
+	if qi.Description != nil {
+		op["description"] = *qi.Description
+	}
+	op["parameters"] = params
+	sb.paths["/_query/"+qi.Name] = obj{"get": op}
+}
+
+// backendSpec generates OpenAPI document describing entities and named queries of backend.
+func (rs *restServer) backendSpec(ctx context.Context, backend string, c crud.Interface) (obj, error) {
+	sb := &specBuilder{
+		rs:      rs,
+		backend: backend,
+		be:      rs.cfg.Backends[backend],
+		p:       auth.FromContext(ctx),
+		paths:   obj{},
+		schemas: obj{},
+	}
+	entities, err := c.ListEntities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		if !rs.authz.CanSeeEntity(sb.p, backend, entity) {
+			continue
+		}
+		es, err := c.DescribeEntity(ctx, entity)
+		if err != nil {
+			rs.l.Warn("unable to describe entity", "backend", backend, "entity", entity, "error", err)
+			continue
+		}
+		sb.addEntity(es)
+	}
+	queries, err := c.DescribeQueries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range queries {
+		if rs.authz.Authorize(sb.p, auth.OpQuery, backend, queries[i].Name) == nil {
+			sb.addQuery(&queries[i])
+		}
+	}
+	sb.schemas["ErrorObject"] = obj{
+		"type":       "object",
+		"required":   []string{"message"},
+		"properties": obj{"message": obj{"type": "string"}},
+	}
+	return obj{
+		"openapi": "3.1.0",
+		"info": obj{
+			"title":   "Database to REST API bridge - " + backend,
+			"version": "v1.0.0",
+		},
+		"servers": []interface{}{obj{"url": rs.cfg.Server.APIPrefix + "/" + backend}},
+		"paths":   sb.paths,
+		"components": obj{
+			"schemas":    sb.schemas,
+			"parameters": specParams,
+		},
+	}, nil
+}
+
+// specHandler serves OpenAPI document generated from schema of backend.
+func (rs *restServer) specHandler(w http.ResponseWriter, r *http.Request) {
+	backend := mux.Vars(r)["backend"]
+	rs.handleBackend(w, r, backend, func(c crud.Interface, writer http.ResponseWriter, request *http.Request) {
+		if spec, err := rs.backendSpec(request.Context(), backend, c); err != nil {
+			out.SendWithStatus(writer, err, http.StatusInternalServerError)
+		} else {
+			out.SendWithStatus(writer, spec, http.StatusOK)
+		}
+	})
+}
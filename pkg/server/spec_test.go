@@ -0,0 +1,198 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	ccfg "github.com/rkosegi/go-http-commons/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// describingCrud implements only describing part of crud.Interface
+type describingCrud struct {
+	crud.Interface
+}
+
+func (describingCrud) ListEntities(context.Context) ([]string, error) {
+	return []string{"emp", "salary"}, nil
+}
+
+func (describingCrud) DescribeEntity(_ context.Context, entity string) (*api.EntitySchema, error) {
+	return &api.EntitySchema{
+		Name:     entity,
+		IdColumn: "id",
+		Columns: []api.SchemaColumn{
+			{Name: "id", Type: "INT", ColumnType: "int unsigned", AutoIncrement: true},
+			{Name: "name", Type: "VARCHAR", ColumnType: "varchar(64)", MaxLength: new(int64(64))},
+			{Name: "note", Type: "TEXT", ColumnType: "text", Nullable: true},
+		},
+	}, nil
+}
+
+func (describingCrud) DescribeQueries(context.Context) ([]api.QueryInfo, error) {
+	return []api.QueryInfo{{
+		Name: "by_dept",
+		Params: []api.QueryParamInfo{
+			{Name: "depts", Type: types.ParamList, ItemType: new(types.ParamInt), Max: new(float32(3)), Default: new("1,2")},
+		},
+		Columns: &[]api.ColumnInfo{{Name: "cnt", Type: "BIGINT"}},
+	}, {Name: "secret"}}, nil
+}
+
+func TestBackendSpec(t *testing.T) {
+	authz, err := auth.NewAuthorizer(&types.AuthorizationConfig{
+		DefaultRoles: []string{"reader"},
+		Roles: map[string][]*types.GrantConfig{
+			"reader": {
+				{Backends: []string{"demo"}, Entities: []string{"emp"}, Queries: []string{"by_*"}, Operations: []string{"list", "get", "query", "update", "bulk"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	rs := &restServer{
+		l:     slog.Default(),
+		authz: authz,
+		cfg: &types.Config{
+			Server: ccfg.ServerConfig{APIPrefix: "/api/v1"},
+			Backends: types.Backends{"demo": {
+				Read: &types.TRUE, Create: &types.TRUE, Update: &types.FALSE, Delete: &types.TRUE,
+			}},
+		},
+	}
+	spec, err := rs.backendSpec(context.Background(), "demo", describingCrud{})
+	assert.NoError(t, err)
+	assert.Equal(t, "3.1.0", spec["openapi"])
+	assert.Equal(t, "/api/v1/demo", spec["servers"].([]interface{})[0].(obj)["url"])
+
+	paths := spec["paths"].(obj)
+	assert.Contains(t, paths, "/emp")
+	assert.Contains(t, paths, "/emp/{id}")
+	assert.Contains(t, paths, "/_query/by_dept")
+	// entity and query are not visible to principal
+	assert.NotContains(t, paths, "/salary")
+	assert.NotContains(t, paths, "/_query/secret")
+	// create is not granted, update is disabled in backend, delete is not granted
+	assert.Contains(t, paths["/emp"], "get")
+	assert.NotContains(t, paths["/emp"], "post")
+	assert.NotContains(t, paths["/emp/{id}"], "put")
+	assert.NotContains(t, paths["/emp/{id}"], "delete")
+	assert.NotContains(t, paths, "/emp/bulk")
+
+	schemas := spec["components"].(obj)["schemas"].(obj)
+	emp := schemas["emp"].(obj)
+	props := emp["properties"].(obj)
+	assert.Equal(t, obj{"type": "integer", "minimum": 0}, props["id"])
+	assert.Equal(t, obj{"type": "string", "maxLength": int64(64)}, props["name"])
+	assert.Equal(t, obj{"type": []interface{}{"string", "null"}}, props["note"])
+	assert.Equal(t, []string{"name"}, schemas["emp.create"].(obj)["required"])
+	assert.Equal(t, obj{"type": "integer", "format": "int64"}, schemas["query.by_dept"].(obj)["properties"].(obj)["cnt"])
+
+	params := paths["/_query/by_dept"].(obj)["get"].(obj)["parameters"].([]interface{})
+	depts := params[len(params)-1].(obj)
+	assert.Equal(t, "p.depts", depts["name"])
+	assert.Equal(t, obj{
+		"type":     "array",
+		"items":    obj{"type": "integer"},
+		"maxItems": float32(3),
+		"default":  []string{"1", "2"},
+	}, depts["schema"])
+}
+
+func TestListQueries(t *testing.T) {
+	authz, err := auth.NewAuthorizer(&types.AuthorizationConfig{
+		DefaultRoles: []string{"reader"},
+		Roles: map[string][]*types.GrantConfig{
+			"reader": {{Backends: []string{"demo"}, Queries: []string{"by_*"}, Operations: []string{"query"}}},
+		},
+	})
+	assert.NoError(t, err)
+	rs := &restServer{
+		l:       slog.Default(),
+		authz:   authz,
+		cfg:     &types.Config{},
+		crudMap: crud.NameToCrudMap{"demo": describingCrud{}},
+	}
+	rec := httptest.NewRecorder()
+	rs.ListQueries(rec, httptest.NewRequest(http.MethodGet, "/demo/_query", nil), "demo")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var queries []api.QueryInfo
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &queries))
+	// query that can't be run is not listed at all
+	assert.Len(t, queries, 1)
+	assert.Equal(t, "by_dept", queries[0].Name)
+	assert.True(t, queries[0].Permissions.Execute)
+}
+
+func TestBackendSpecVirtualEntity(t *testing.T) {
+	authz, err := auth.NewAuthorizer(&types.AuthorizationConfig{
+		DefaultRoles: []string{"admin"},
+		Roles: map[string][]*types.GrantConfig{
+			"admin": {{Backends: []string{"*"}, Entities: []string{"*"}, Operations: []string{"*", "purge"}}},
+		},
+	})
+	assert.NoError(t, err)
+	rs := &restServer{
+		l:     slog.Default(),
+		authz: authz,
+		cfg: &types.Config{
+			Backends: types.Backends{"demo": {
+				Read: &types.TRUE, Create: &types.TRUE, Update: &types.TRUE, Delete: &types.TRUE,
+				Entities: map[string]*types.EntityConfig{
+					"salary": {SQL: new("SELECT * FROM salaries")},
+					"emp":    {SoftDelete: &types.SoftDeleteConfig{Column: "deleted_at", Marker: new(types.SoftDeleteTimestamp)}},
+				},
+			}},
+		},
+	}
+	spec, err := rs.backendSpec(context.Background(), "demo", describingCrud{})
+	assert.NoError(t, err)
+	paths := spec["paths"].(obj)
+	assert.Contains(t, paths["/emp"], "post")
+	assert.Contains(t, paths, "/emp/bulk")
+	// soft delete
+	assert.Contains(t, paths, "/emp/{id}/_restore")
+	assert.Len(t, paths["/emp"].(obj)["get"].(obj)["parameters"], 6)
+	assert.Contains(t, paths["/emp/{id}"].(obj)["delete"], "parameters")
+	// purge is not part of wildcard grant
+	rs.authz, err = auth.NewAuthorizer(&types.AuthorizationConfig{
+		DefaultRoles: []string{"admin"},
+		Roles: map[string][]*types.GrantConfig{
+			"admin": {{Backends: []string{"*"}, Entities: []string{"*"}, Operations: []string{"*"}}},
+		},
+	})
+	assert.NoError(t, err)
+	nopurge, err := rs.backendSpec(context.Background(), "demo", describingCrud{})
+	assert.NoError(t, err)
+	assert.NotContains(t, nopurge["paths"].(obj)["/emp/{id}"].(obj)["delete"], "parameters")
+	// virtual entity is read-only
+	assert.Contains(t, paths["/salary"], "get")
+	assert.NotContains(t, paths["/salary"], "post")
+	assert.Contains(t, paths["/salary/{id}"], "get")
+	assert.NotContains(t, paths["/salary/{id}"], "put")
+	assert.NotContains(t, paths["/salary/{id}"], "delete")
+	assert.NotContains(t, paths, "/salary/bulk")
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/events"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/samber/lo"
+	"golang.org/x/net/websocket"
+)
+
+// how long can single write to WebSocket client take
+const wsWriteTimeout = 10 * time.Second
+
+type rawWriterKey struct{}
+
+// keepRawWriter makes response writer of server available to change stream, as writers of other middlewares
+// can't be flushed. It must be outermost middleware.
+func keepRawWriter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/_events") {
+			r = r.WithContext(context.WithValue(r.Context(), rawWriterKey{}, w))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func rawWriter(r *http.Request, w http.ResponseWriter) http.ResponseWriter {
+	if raw, ok := r.Context().Value(rawWriterKey{}).(http.ResponseWriter); ok {
+		return raw
+	}
+	return w
+}
+
+// streamMessage is heartbeat or envelope of event sent to WebSocket client.
+type streamMessage struct {
+	Type  string        `json:"type"`
+	Event *events.Event `json:"event,omitempty"`
+	Time  *time.Time    `json:"time,omitempty"`
+}
+
+// eventFilter selects events that subscriber of change stream receives.
+type eventFilter struct {
+	c       crud.Interface
+	backend string
+	entity  string
+	// optional filter expression
+	fe query.FilterExpression
+}
+
+// accepts checks that event concerns entity, that item matches filter and that principal in context may read it.
+// Both are evaluated against full item rather than redacted payload of event.
+func (ef *eventFilter) accepts(ctx context.Context, ev *events.Event) (bool, error) {
+	if ev.Backend != ef.backend || ev.Entity != ef.entity {
+		return false, nil
+	}
+	if ef.fe != nil {
+		if ok, err := query.Match(ef.fe, ev.Row); err != nil || !ok {
+			return false, err
+		}
+	}
+	return ef.c.Visible(ctx, ef.entity, ev.Row)
+}
+
+// StreamEvents streams change events of entity. Unlike other handlers, it doesn't go through handleEntity,
+// since stream is long-lived and would hold slot of concurrency limit for whole its life.
+func (rs *restServer) StreamEvents(w http.ResponseWriter, r *http.Request, backend api.Backend, entity api.Entity, params api.StreamEventsParams) {
+	stream := rs.publisher.Stream()
+	if stream == nil {
+		http.Error(w, "change stream is not enabled", http.StatusNotFound)
+		return
+	}
+	c, ok := rs.crudMap[backend]
+	if !ok || !rs.authz.CanSeeBackend(auth.FromContext(r.Context()), backend) {
+		http.Error(w, fmt.Sprintf("no such backend: %s", backend), http.StatusBadRequest)
+		return
+	}
+	if !rs.rateLimiters[backend].check(w, r) {
+		return
+	}
+	if rs.cfg.Backends[backend].Shadowed(entity) {
+		http.Error(w, fmt.Sprintf("no such entity: %s", entity), http.StatusNotFound)
+		return
+	}
+	if err := rs.authorize(r, auth.OpList, backend, entity); err != nil {
+		out.SendWithStatus(w, err, http.StatusForbidden)
+		return
+	}
+	qry, err := query.FromParamsRSQL(nil, nil, nil, params.Filter, params.Q)
+	if err != nil {
+		out.SendWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	// fail early when entity doesn't exist or when principal doesn't fit row policy
+	if _, err = c.DescribeEntity(r.Context(), entity); err == nil {
+		_, err = c.Visible(r.Context(), entity, nil)
+	}
+	if err != nil {
+		out.SendWithStatus(w, err, http.StatusInternalServerError)
+		return
+	}
+	ef := &eventFilter{c: c, backend: backend, entity: entity, fe: qry.Filter()}
+	sub := stream.Subscribe(lo.FromPtrOr(params.Since, lo.FromPtr(params.LastEventID)))
+	defer sub.Close()
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		websocket.Server{
+			Handshake: rs.checkOrigin,
+			Handler: func(ws *websocket.Conn) {
+				rs.streamWebSocket(ws, sub, ef, stream.Heartbeat())
+			},
+		}.ServeHTTP(w, r)
+		return
+	}
+	rs.streamSSE(w, r, sub, ef, stream.Heartbeat())
+}
+
+// checkOrigin accepts WebSocket handshake from origins allowed by CORS configuration, or without origin at all.
+func (rs *restServer) checkOrigin(_ *websocket.Config, r *http.Request) error {
+	origins := rs.cfg.Server.Cors.AllowedOrigins
+	if origin := r.Header.Get("Origin"); origin != "" && !slices.Contains(origins, "*") && !slices.Contains(origins, origin) {
+		return fmt.Errorf("origin not allowed: %s", origin)
+	}
+	return nil
+}
+
+// pump forwards accepted events and periodic heartbeats to subscriber until context is done, sending fails
+// or subscription is dropped for not keeping up.
+func (rs *restServer) pump(ctx context.Context, sub *events.Subscription, ef *eventFilter, heartbeat time.Duration,
+	send func(ev *events.Event) error, beat func(now time.Time) error) {
+	t := time.NewTicker(heartbeat)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-sub.Events():
+			if !ok {
+				rs.l.DebugContext(ctx, "subscriber of change stream can't keep up, dropping it", "entity", ef.entity)
+				return
+			}
+			if ok, err := ef.accepts(ctx, ev); err != nil {
+				rs.l.WarnContext(ctx, "unable to evaluate event", "err", err, "event", ev.EventID)
+			} else if ok {
+				if err = send(ev); err != nil {
+					return
+				}
+			}
+		case now := <-t.C:
+			if err := beat(now.UTC()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (rs *restServer) streamSSE(w http.ResponseWriter, r *http.Request, sub *events.Subscription, ef *eventFilter, heartbeat time.Duration) {
+	rc := http.NewResponseController(rawWriter(r, w))
+	// stream outlives write timeout of server
+	_ = rc.SetWriteDeadline(time.Time{})
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// disable buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		rs.l.ErrorContext(r.Context(), "change stream requires response writer that can be flushed", "err", err)
+		return
+	}
+	write := func(id, event string, data interface{}) error {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if err = writeSSE(w, id, event, payload); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	rs.pump(r.Context(), sub, ef, heartbeat, func(ev *events.Event) error {
+		return write(ev.EventID, "", ev)
+	}, func(now time.Time) error {
+		return write("", "heartbeat", streamMessage{Type: "heartbeat", Time: &now})
+	})
+}
+
+// writeSSE writes single message of Server-Sent Events, empty id and event are omitted.
+func writeSSE(w io.Writer, id, event string, data []byte) error {
+	var sb strings.Builder
+	if id != "" {
+		sb.WriteString("id: " + id + "\n")
+	}
+	if event != "" {
+		sb.WriteString("event: " + event + "\n")
+	}
+	sb.WriteString("data: ")
+	sb.Write(data)
+	sb.WriteString("\n\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func (rs *restServer) streamWebSocket(ws *websocket.Conn, sub *events.Subscription, ef *eventFilter, heartbeat time.Duration) {
+	defer func() {
+		_ = ws.Close()
+	}()
+	ctx, cancel := context.WithCancel(ws.Request().Context())
+	defer cancel()
+	// client isn't expected to send anything, reading only detects that connection was closed
+	go func() {
+		defer cancel()
+		var msg []byte
+		for {
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				if !errors.Is(err, io.EOF) {
+					rs.l.DebugContext(ctx, "WebSocket client is gone", "err", err)
+				}
+				return
+			}
+		}
+	}()
+	send := func(msg *streamMessage) error {
+		_ = ws.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		return websocket.JSON.Send(ws, msg)
+	}
+	rs.pump(ctx, sub, ef, heartbeat, func(ev *events.Event) error {
+		return send(&streamMessage{Type: "event", Event: ev})
+	}, func(now time.Time) error {
+		return send(&streamMessage{Type: "heartbeat", Time: &now})
+	})
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rkosegi/db2rest-bridge/pkg/api"
+	"github.com/rkosegi/db2rest-bridge/pkg/audit"
+	"github.com/rkosegi/db2rest-bridge/pkg/crud"
+	"github.com/rkosegi/db2rest-bridge/pkg/events"
+	"github.com/rkosegi/db2rest-bridge/pkg/query"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	ccfg "github.com/rkosegi/go-http-commons/config"
+	"github.com/rkosegi/go-http-commons/middlewares"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+// streamingCrud knows every entity but salary, items owned by "secret" are not visible
+type streamingCrud struct {
+	crud.Interface
+}
+
+func (streamingCrud) DescribeEntity(_ context.Context, entity string) (*api.EntitySchema, error) {
+	if entity == "salary" {
+		return nil, types.NewErrorWithStatus("no such entity", http.StatusNotFound)
+	}
+	return &api.EntitySchema{Name: entity, IdColumn: "id"}, nil
+}
+
+func (streamingCrud) Visible(_ context.Context, _ string, item api.UntypedDto) (bool, error) {
+	return item == nil || item["owner"] != "secret", nil
+}
+
+type sseMessage struct {
+	id, event, data string
+}
+
+func newStreamServer(t *testing.T, p *events.Publisher) *httptest.Server {
+	rs := &restServer{
+		l: slog.Default(),
+		cfg: &types.Config{
+			Server: ccfg.ServerConfig{Cors: &ccfg.CorsConfig{AllowedOrigins: []string{"http://localhost"}}},
+			Backends: types.Backends{"demo": {Entities: map[string]*types.EntityConfig{
+				"people": {Table: new("staff")},
+			}}},
+		},
+		crudMap:   crud.NameToCrudMap{"demo": streamingCrud{}},
+		publisher: p,
+	}
+	srv := httptest.NewServer(api.HandlerWithOptions(rs, api.GorillaServerOptions{
+		BaseRouter: mux.NewRouter(),
+		// logging wraps response writer, so that it can't be flushed
+		Middlewares: []api.MiddlewareFunc{middlewares.NewLoggingBuilder().Build(), keepRawWriter},
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newStreamPublisher(t *testing.T) *events.Publisher {
+	p, err := events.New(&types.EventsConfig{Stream: &types.StreamConfig{
+		BufferSize: new(10), Heartbeat: new(50 * time.Millisecond),
+	}}, slog.Default())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, p.Close())
+	})
+	return p
+}
+
+func record(entity string, op audit.Operation, item api.UntypedDto) *audit.Record {
+	rec := &audit.Record{Backend: "demo", Entity: entity, Operation: op, ID: item["id"], Row: item}
+	if op == audit.OpDelete {
+		rec.Before = item
+	} else {
+		rec.After = item
+	}
+	return rec
+}
+
+// readSSE parses messages of event stream until body is closed.
+func readSSE(resp *http.Response) <-chan sseMessage {
+	ch := make(chan sseMessage, 100)
+	go func() {
+		defer close(ch)
+		var msg sseMessage
+		s := bufio.NewScanner(resp.Body)
+		for s.Scan() {
+			line := s.Text()
+			switch {
+			case line == "":
+				ch <- msg
+				msg = sseMessage{}
+			case strings.HasPrefix(line, "id: "):
+				msg.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				msg.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				msg.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return ch
+}
+
+// nextEvent skips heartbeats and decodes next event.
+func nextEvent(t *testing.T, ch <-chan sseMessage) *events.Event {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				t.Fatal("stream ended")
+			}
+			if msg.event == "heartbeat" {
+				continue
+			}
+			var ev events.Event
+			assert.NoError(t, json.Unmarshal([]byte(msg.data), &ev))
+			assert.Equal(t, msg.id, ev.EventID)
+			return &ev
+		case <-timeout:
+			t.Fatal("no event received")
+		}
+	}
+}
+
+func openStream(t *testing.T, url string, header http.Header) <-chan sseMessage {
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+	return readSSE(resp)
+}
+
+func TestStreamEventsSSE(t *testing.T) {
+	p := newStreamPublisher(t)
+	srv := newStreamServer(t, p)
+	ch := openStream(t, srv.URL+"/demo/emp/_events?q=dept==IT", nil)
+
+	p.Publish(context.TODO(), []*audit.Record{
+		record("emp", audit.OpCreate, api.UntypedDto{"id": 1, "dept": "HR"}),
+		record("dept", audit.OpCreate, api.UntypedDto{"id": 2, "dept": "IT"}),
+		record("emp", audit.OpCreate, api.UntypedDto{"id": 3, "dept": "IT", "owner": "secret"}),
+		record("emp", audit.OpDelete, api.UntypedDto{"id": 4, "dept": "IT"}),
+	})
+	ev := nextEvent(t, ch)
+	assert.Equal(t, audit.OpDelete, ev.Operation)
+	assert.Equal(t, "IT", ev.Old["dept"])
+
+	// heartbeat is sent to idle client
+	timeout := time.After(5 * time.Second)
+	for beat := false; !beat; {
+		select {
+		case msg := <-ch:
+			beat = msg.event == "heartbeat"
+		case <-timeout:
+			t.Fatal("no heartbeat received")
+		}
+	}
+
+	// resume after first of two events
+	p.Publish(context.TODO(), []*audit.Record{
+		record("emp", audit.OpUpdate, api.UntypedDto{"id": 5, "dept": "IT"}),
+		record("emp", audit.OpUpdate, api.UntypedDto{"id": 6, "dept": "IT"}),
+	})
+	first := nextEvent(t, ch)
+	assert.Equal(t, float64(5), first.ID)
+	assert.Equal(t, float64(6), nextEvent(t, ch).ID)
+	resumed := openStream(t, srv.URL+"/demo/emp/_events", http.Header{"Last-Event-Id": {first.EventID}})
+	assert.Equal(t, float64(6), nextEvent(t, resumed).ID)
+	resumed = openStream(t, srv.URL+"/demo/emp/_events?since="+first.EventID, nil)
+	assert.Equal(t, float64(6), nextEvent(t, resumed).ID)
+}
+
+func TestStreamEventsWebSocket(t *testing.T) {
+	p := newStreamPublisher(t)
+	srv := newStreamServer(t, p)
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/demo/emp/_events"
+
+	_, err := websocket.Dial(url, "", "http://evil.example.com")
+	assert.Error(t, err)
+
+	ws, err := websocket.Dial(url, "", "http://localhost")
+	assert.NoError(t, err)
+	defer func() {
+		_ = ws.Close()
+	}()
+	p.Publish(context.TODO(), []*audit.Record{record("emp", audit.OpCreate, api.UntypedDto{"id": 1})})
+	_ = ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var msg streamMessage
+		assert.NoError(t, websocket.JSON.Receive(ws, &msg))
+		if msg.Type == "heartbeat" {
+			assert.NotNil(t, msg.Time)
+			continue
+		}
+		assert.Equal(t, "event", msg.Type)
+		assert.Equal(t, audit.OpCreate, msg.Event.Operation)
+		assert.Equal(t, float64(1), msg.Event.ID)
+		break
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	ef := &eventFilter{c: streamingCrud{}, backend: "demo", entity: "emp", fe: query.SimpleExpr("dept", query.OpEq, "IT")}
+	// payload is partial and redacted, full row decides
+	ok, err := ef.accepts(t.Context(), &events.Event{Backend: "demo", Entity: "emp", Operation: audit.OpUpdate,
+		New: api.UntypedDto{"photo": "<10 bytes>"}, Row: api.UntypedDto{"dept": "IT", "photo": []byte("0123456789")}})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = ef.accepts(t.Context(), &events.Event{Backend: "demo", Entity: "emp", Operation: audit.OpDelete,
+		Old: api.UntypedDto{"dept": "IT", "owner": "***"}, Row: api.UntypedDto{"dept": "IT", "owner": "secret"}})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = ef.accepts(t.Context(), &events.Event{Backend: "demo", Entity: "emp", Operation: audit.OpRestore,
+		New: api.UntypedDto{"dept": "IT"}, Row: api.UntypedDto{"dept": "HR"}})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestStreamEventsErrors(t *testing.T) {
+	srv := newStreamServer(t, newStreamPublisher(t))
+	for url, status := range map[string]int{
+		"/demo/emp/_events?q=name": http.StatusBadRequest,
+		"/demo/salary/_events":     http.StatusNotFound,
+		"/demo/staff/_events":      http.StatusNotFound,
+		"/other/emp/_events":       http.StatusBadRequest,
+	} {
+		resp, err := http.Get(srv.URL + url)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, status, resp.StatusCode, url)
+	}
+	// stream is not enabled
+	resp, err := http.Get(newStreamServer(t, nil).URL + "/demo/emp/_events")
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
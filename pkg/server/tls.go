@@ -0,0 +1,173 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	ccfg "github.com/rkosegi/go-http-commons/config"
+)
+
+// tlsReloader keeps TLS configuration in sync with files on disk.
+// Files are checked for modification at most once per reload interval, during TLS handshake.
+type tlsReloader struct {
+	cfg *types.TLSConfig
+	l   *slog.Logger
+	now func() time.Time
+
+	mu        sync.Mutex
+	current   *tls.Config
+	modTimes  []time.Time
+	lastCheck time.Time
+}
+
+func newTlsReloader(cfg *types.TLSConfig, l *slog.Logger) (*tlsReloader, error) {
+	tr := &tlsReloader{cfg: cfg, l: l, now: time.Now}
+	if err := tr.reload(); err != nil {
+		return nil, err
+	}
+	tr.lastCheck = tr.now()
+	return tr, nil
+}
+
+func (tr *tlsReloader) files() []string {
+	f := []string{tr.cfg.CertFile, tr.cfg.KeyFile}
+	if tr.cfg.ClientCAFile != nil {
+		f = append(f, *tr.cfg.ClientCAFile)
+	}
+	return f
+}
+
+func (tr *tlsReloader) stat() ([]time.Time, error) {
+	var mt []time.Time
+	for _, f := range tr.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		mt = append(mt, fi.ModTime())
+	}
+	return mt, nil
+}
+
+// reload builds new TLS configuration from files.
+func (tr *tlsReloader) reload() error {
+	mt, err := tr.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(tr.cfg.CertFile, tr.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	tc := &tls.Config{
+		MinVersion:   tr.cfg.TLSVersion(),
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tr.cfg.ClientAuthType(),
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if tr.cfg.ClientCAFile != nil {
+		data, err := os.ReadFile(*tr.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		tc.ClientCAs = x509.NewCertPool()
+		if !tc.ClientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in %s", *tr.cfg.ClientCAFile)
+		}
+	}
+	tr.current = tc
+	tr.modTimes = mt
+	return nil
+}
+
+// get returns current configuration, reloading it first if any file has changed.
+// When reload fails, previous configuration is kept, so that half-written files don't break serving.
+func (tr *tlsReloader) get() *tls.Config {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	now := tr.now()
+	if now.Sub(tr.lastCheck) < *tr.cfg.ReloadInterval {
+		return tr.current
+	}
+	tr.lastCheck = now
+	mt, err := tr.stat()
+	if err != nil {
+		tr.l.Warn("unable to check TLS files", "err", err)
+		return tr.current
+	}
+	for i := range mt {
+		if !mt[i].Equal(tr.modTimes[i]) {
+			if err = tr.reload(); err != nil {
+				tr.l.Warn("unable to reload TLS configuration", "err", err)
+			} else {
+				tr.l.Info("TLS configuration reloaded")
+			}
+			break
+		}
+	}
+	return tr.current
+}
+
+func (tr *tlsReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tr.cfg.TLSVersion(),
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return tr.get(), nil
+		},
+	}
+}
+
+// serveTLS serves HTTPS until context is done, honoring timeouts from server configuration.
+func serveTLS(ctx context.Context, sc *ccfg.ServerConfig, srv *http.Server, tr *tlsReloader) error {
+	if sc.ReadHeaderTimeout != nil {
+		srv.ReadHeaderTimeout = *sc.ReadHeaderTimeout
+	}
+	if sc.ReadTimeout != nil {
+		srv.ReadTimeout = *sc.ReadTimeout
+	}
+	if sc.IdleTimeout != nil {
+		srv.IdleTimeout = *sc.IdleTimeout
+	}
+	if sc.WriteTimeout != nil {
+		srv.WriteTimeout = *sc.WriteTimeout
+	}
+	srv.TLSConfig = tr.tlsConfig()
+	l, err := net.Listen("tcp", sc.ListenAddress)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err = srv.ServeTLS(l, "", ""); errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rkosegi/db2rest-bridge/pkg/auth"
+	"github.com/rkosegi/db2rest-bridge/pkg/client"
+	"github.com/rkosegi/db2rest-bridge/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func writePem(t *testing.T, file, typ string, der []byte) {
+	assert.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+}
+
+func newTestCA(t *testing.T, file string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	writePem(t, file, "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, cn string, serial int64, usage x509.ExtKeyUsage, certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"acme"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	writePem(t, certFile, "CERTIFICATE", der)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	writePem(t, keyFile, "EC PRIVATE KEY", keyDer)
+}
+
+func TestMutualTLS(t *testing.T) {
+	var serial int64
+	dir := t.TempDir()
+	f := func(name string) string { return filepath.Join(dir, name) }
+	ca := newTestCA(t, f("ca.pem"))
+	ca.issue(t, "server", 2, x509.ExtKeyUsageServerAuth, f("server.pem"), f("server.key"))
+	ca.issue(t, "batch-job", 3, x509.ExtKeyUsageClientAuth, f("client.pem"), f("client.key"))
+
+	cfg := &types.Config{
+		Backends: types.Backends{"demo": {}},
+		TLS: &types.TLSConfig{
+			CertFile:     f("server.pem"),
+			KeyFile:      f("server.key"),
+			ClientCAFile: new(f("ca.pem")),
+		},
+		Auth: &types.AuthConfig{ClientCert: &types.ClientCertConfig{}},
+	}
+	assert.NoError(t, cfg.CheckAndNormalize())
+	tr, err := newTlsReloader(cfg.TLS, slog.Default())
+	assert.NoError(t, err)
+	authn, err := auth.New(cfg.Auth)
+	assert.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := authn.Authenticate(r)
+		assert.NoError(t, err)
+		_, _ = w.Write([]byte(p.Name + "/" + p.Claims["o"].([]string)[0]))
+	}))
+	srv.TLS = tr.tlsConfig()
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(opts ...client.TLSOpt) (*http.Response, error) {
+		tc, err := client.NewTLSConfig(append(opts, client.CustomCA(f("ca.pem")))...)
+		assert.NoError(t, err)
+		tc.VerifyConnection = func(cs tls.ConnectionState) error {
+			// remember serial of server certificate to detect reload
+			serial = cs.PeerCertificates[0].SerialNumber.Int64()
+			return nil
+		}
+		hc := &http.Client{Transport: &http.Transport{TLSClientConfig: tc}}
+		return hc.Get(srv.URL)
+	}
+
+	t.Run("client certificate is required", func(t *testing.T) {
+		_, err = get()
+		assert.Error(t, err)
+	})
+
+	t.Run("principal from client certificate", func(t *testing.T) {
+		resp, err := get(client.ClientCertificate(f("client.pem"), f("client.key")))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "batch-job/acme", string(body))
+		assert.Equal(t, int64(2), serial)
+	})
+
+	t.Run("certificate is reloaded on change", func(t *testing.T) {
+		ca.issue(t, "server", 4, x509.ExtKeyUsageServerAuth, f("server.pem"), f("server.key"))
+		future := time.Now().Add(time.Minute)
+		assert.NoError(t, os.Chtimes(f("server.pem"), future, future))
+		tr.now = func() time.Time { return future }
+		resp, err := get(client.ClientCertificate(f("client.pem"), f("client.key")))
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, int64(4), serial)
+	})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import "fmt"
+
+const (
+	// AuditSinkFile writes JSON lines into file, with size-based rotation
+	AuditSinkFile = "file"
+	// AuditSinkLog writes records using application logger
+	AuditSinkLog = "log"
+	// AuditSinkTable inserts records into table in backend database, within same transaction as audited change
+	AuditSinkTable = "table"
+)
+
+var (
+	defaultAuditMaxSizeMB  = 100
+	defaultAuditMaxBackups = 5
+	defaultAuditTable      = "audit_log"
+)
+
+// AuditConfig enables audit log of all data-changing operations.
+type AuditConfig struct {
+	Sinks []*AuditSinkConfig `yaml:"sinks"`
+}
+
+// AuditSinkConfig configures single destination of audit records.
+type AuditSinkConfig struct {
+	// One of file, log or table
+	Type string `yaml:"type"`
+	// Path to file, required for file sink
+	Path *string `yaml:"path,omitempty"`
+	// Size in megabytes after which file is rotated, default is 100
+	MaxSizeMB *int `yaml:"max_size_mb,omitempty"`
+	// Number of rotated files to keep, default is 5
+	MaxBackups *int `yaml:"max_backups,omitempty"`
+	// Name of audit table for table sink, default is "audit_log". Table must exist in every backend.
+	Table *string `yaml:"table,omitempty"`
+}
+
+func (ac *AuditConfig) checkAndNormalize() error {
+	if len(ac.Sinks) == 0 {
+		return fmt.Errorf("audit requires at least one sink")
+	}
+	for i, s := range ac.Sinks {
+		switch s.Type {
+		case AuditSinkFile:
+			if s.Path == nil || len(*s.Path) == 0 {
+				return fmt.Errorf("audit sink #%d: path is required", i)
+			}
+			if s.MaxSizeMB == nil {
+				s.MaxSizeMB = &defaultAuditMaxSizeMB
+			}
+			if s.MaxBackups == nil {
+				s.MaxBackups = &defaultAuditMaxBackups
+			}
+			if *s.MaxSizeMB <= 0 || *s.MaxBackups < 0 {
+				return fmt.Errorf("audit sink #%d: invalid rotation settings", i)
+			}
+		case AuditSinkLog:
+		case AuditSinkTable:
+			if s.Table == nil {
+				s.Table = &defaultAuditTable
+			}
+			if !identRE.MatchString(*s.Table) {
+				return fmt.Errorf("audit sink #%d: invalid table name: %s", i, *s.Table)
+			}
+		default:
+			return fmt.Errorf("audit sink #%d: unknown type: %s", i, s.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"path"
+)
+
+var (
+	defaultAPIKeyHeader = "X-API-Key"
+	defaultSubjectClaim = "sub"
+	defaultCertSubject  = CertSubjectCN
+	matchAll            = []string{"*"}
+)
+
+const (
+	// CertSubjectCN uses common name of client certificate as name of principal
+	CertSubjectCN = "cn"
+	// CertSubjectDN uses full distinguished name of client certificate as name of principal
+	CertSubjectDN = "dn"
+)
+
+// AuthConfig configures how callers are authenticated.
+type AuthConfig struct {
+	// Name of HTTP header that carries API key, "X-API-Key" is assumed when omitted
+	APIKeyHeader *string `yaml:"api_key_header,omitempty"`
+	// Mapping from principal name to its API key
+	APIKeys map[string]string `yaml:"api_keys,omitempty"`
+	// Optional validation of JWT bearer tokens
+	JWT *JWTConfig `yaml:"jwt,omitempty"`
+	// Optional authentication by verified TLS client certificate, requires tls.client_ca_file
+	ClientCert *ClientCertConfig `yaml:"client_cert,omitempty"`
+	// When true, requests without any credentials are let through as anonymous.
+	AllowAnonymous *bool `yaml:"allow_anonymous,omitempty"`
+}
+
+// JWTConfig configures validation of bearer tokens.
+// Exactly one of Secret or PublicKeyFile must be provided.
+type JWTConfig struct {
+	// Shared secret for HS256, HS384 and HS512 signed tokens
+	Secret *string `yaml:"secret,omitempty"`
+	// Path to PEM-encoded RSA or ECDSA public key for RS* or ES* signed tokens
+	PublicKeyFile *string `yaml:"public_key_file,omitempty"`
+	// Expected value of "iss" claim, not checked if omitted
+	Issuer *string `yaml:"issuer,omitempty"`
+	// Expected value of "aud" claim, not checked if omitted
+	Audience *string `yaml:"audience,omitempty"`
+	// Claim that holds name of principal, "sub" is assumed when omitted
+	SubjectClaim *string `yaml:"subject_claim,omitempty"`
+	// Optional claim that holds list of roles
+	RolesClaim *string `yaml:"roles_claim,omitempty"`
+}
+
+// ClientCertConfig configures how principal is derived from verified client certificate.
+// Explicit credentials (API key, JWT) take precedence over client certificate.
+type ClientCertConfig struct {
+	// Part of certificate subject that is used as name of principal, either "cn" (default) or "dn"
+	Subject *string `yaml:"subject,omitempty"`
+}
+
+// AuthorizationConfig is role-based access policy.
+// When present, every operation is denied unless some role of caller grants it.
+type AuthorizationConfig struct {
+	// Mapping from principal name to list of roles
+	Principals map[string][]string `yaml:"principals,omitempty"`
+	// Roles assigned to every caller, including anonymous one
+	DefaultRoles []string `yaml:"default_roles,omitempty"`
+	// Mapping from role name to list of grants
+	Roles map[string][]*GrantConfig `yaml:"roles"`
+}
+
+// GrantConfig allows set of operations on matching backends and entities or named queries.
+// All patterns use syntax of path.Match, omitted patterns match everything.
+type GrantConfig struct {
+	Backends []string `yaml:"backends,omitempty"`
+	Entities []string `yaml:"entities,omitempty"`
+	Queries  []string `yaml:"queries,omitempty"`
+	Commands []string `yaml:"commands,omitempty"`
+	// One or more of list, get, create, update, delete, bulk, query, command or "*"
+	Operations []string `yaml:"operations"`
+}
+
+func (ac *AuthConfig) checkAndNormalize() error {
+	if ac.APIKeyHeader == nil {
+		ac.APIKeyHeader = &defaultAPIKeyHeader
+	}
+	if ac.AllowAnonymous == nil {
+		ac.AllowAnonymous = &FALSE
+	}
+	for name, key := range ac.APIKeys {
+		if len(key) == 0 {
+			return fmt.Errorf("empty API key for principal: %s", name)
+		}
+	}
+	if ac.JWT != nil {
+		if (ac.JWT.Secret == nil) == (ac.JWT.PublicKeyFile == nil) {
+			return fmt.Errorf("exactly one of auth.jwt.secret or auth.jwt.public_key_file is required")
+		}
+		if ac.JWT.SubjectClaim == nil {
+			ac.JWT.SubjectClaim = &defaultSubjectClaim
+		}
+	}
+	if ac.ClientCert != nil {
+		if ac.ClientCert.Subject == nil {
+			ac.ClientCert.Subject = &defaultCertSubject
+		}
+		if s := *ac.ClientCert.Subject; s != CertSubjectCN && s != CertSubjectDN {
+			return fmt.Errorf("invalid value of auth.client_cert.subject: %s", s)
+		}
+	}
+	return nil
+}
+
+func checkPatterns(patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return matchAll, nil
+	}
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s': %w", p, err)
+		}
+	}
+	return patterns, nil
+}
+
+func (ac *AuthorizationConfig) checkAndNormalize() (err error) {
+	for role, grants := range ac.Roles {
+		for _, g := range grants {
+			if len(g.Operations) == 0 {
+				return fmt.Errorf("grant in role %s has no operations", role)
+			}
+			if g.Backends, err = checkPatterns(g.Backends); err != nil {
+				return err
+			}
+			if g.Entities, err = checkPatterns(g.Entities); err != nil {
+				return err
+			}
+			if g.Queries, err = checkPatterns(g.Queries); err != nil {
+				return err
+			}
+			if g.Commands, err = checkPatterns(g.Commands); err != nil {
+				return err
+			}
+		}
+	}
+	for principal, roles := range ac.Principals {
+		for _, role := range roles {
+			if _, ok := ac.Roles[role]; !ok {
+				return fmt.Errorf("principal %s refers to unknown role: %s", principal, role)
+			}
+		}
+	}
+	for _, role := range ac.DefaultRoles {
+		if _, ok := ac.Roles[role]; !ok {
+			return fmt.Errorf("unknown default role: %s", role)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import "fmt"
+
+var defaultBlobMaxSizeMB = 16
+
+// BlobConfig configures raw access to content of binary column.
+type BlobConfig struct {
+	// Fixed MIME type of content. When neither this nor ContentTypeColumn is set, type is sniffed from content.
+	ContentType *string `yaml:"content_type,omitempty"`
+	// Optional column of same entity that holds MIME type of content, it's written along with content.
+	ContentTypeColumn *string `yaml:"content_type_column,omitempty"`
+	// Upper bound of uploaded content in megabytes, defaults to 16
+	MaxSizeMB *int `yaml:"max_size_mb,omitempty"`
+}
+
+// DefaultBlobConfig gets configuration of binary columns that have none.
+func DefaultBlobConfig() *BlobConfig {
+	bc := &BlobConfig{}
+	_ = bc.checkAndNormalize()
+	return bc
+}
+
+// MaxSize gets upper bound of content in bytes
+func (bc *BlobConfig) MaxSize() int64 {
+	return int64(*bc.MaxSizeMB) << 20
+}
+
+func (bc *BlobConfig) checkAndNormalize() error {
+	if bc.ContentType != nil && bc.ContentTypeColumn != nil {
+		return fmt.Errorf("content_type and content_type_column are mutually exclusive")
+	}
+	if bc.MaxSizeMB == nil {
+		bc.MaxSizeMB = &defaultBlobMaxSizeMB
+	}
+	if *bc.MaxSizeMB < 1 {
+		return fmt.Errorf("max_size_mb must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+var defaultCacheMaxMemoryMB = 16
+
+// CacheConfig configures cache of responses of named query, or of list requests of entity.
+type CacheConfig struct {
+	// How long are responses kept in cache
+	TTL time.Duration `yaml:"ttl"`
+	// Upper bound of memory occupied by cached responses in megabytes, defaults to 16
+	MaxMemoryMB *int `yaml:"max_memory_mb,omitempty"`
+	// Entities that named query reads from, writes to any of them invalidate cached responses of query.
+	// Cache of entity is always invalidated by writes to entity itself.
+	InvalidatedBy []string `yaml:"invalidated_by,omitempty"`
+}
+
+func (cc *CacheConfig) checkAndNormalize() error {
+	if cc.TTL <= 0 {
+		return fmt.Errorf("cache.ttl must be positive")
+	}
+	if cc.MaxMemoryMB == nil {
+		cc.MaxMemoryMB = &defaultCacheMaxMemoryMB
+	}
+	if *cc.MaxMemoryMB < 1 {
+		return fmt.Errorf("cache.max_memory_mb must be positive")
+	}
+	return nil
+}
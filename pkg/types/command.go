@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var sessionVarRE = regexp.MustCompile(`^\w{1,64}$`)
+
+// Command is curated write operation saved in configuration.
+// All statements are executed in single transaction, in order.
+type Command struct {
+	// Statements to execute, such as INSERT, UPDATE, DELETE or CALL. They can reference named parameters as :<name>.
+	Statements []string `yaml:"statements"`
+	// Optional declaration of named parameters
+	Params map[string]*QueryParam `yaml:"params,omitempty"`
+	// Names of session variables that hold OUT parameters of called procedures, such as "total" for @total.
+	// Their values are returned once all statements are executed.
+	Out []string `yaml:"out,omitempty"`
+}
+
+func (c *Command) checkAndNormalize() error {
+	if len(c.Statements) == 0 {
+		return fmt.Errorf("no statements")
+	}
+	for _, o := range c.Out {
+		if !sessionVarRE.MatchString(o) {
+			return fmt.Errorf("invalid name of OUT parameter: %s", o)
+		}
+	}
+	return checkParams(c.Params, c.Statements...)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+var (
+	defaultWebhookTimeout     = 10 * time.Second
+	defaultWebhookMaxAttempts = 10
+	defaultWebhookMinBackoff  = time.Second
+	defaultWebhookMaxBackoff  = 10 * time.Minute
+	defaultStreamBufferSize   = 1000
+	defaultStreamHeartbeat    = 15 * time.Second
+	webhookNameRE             = regexp.MustCompile(`^[\w-]{1,64}$`)
+	// operations that produce change events
+	eventOperations = []string{"create", "update", "delete", "restore", "purge"}
+)
+
+// EventsConfig enables publishing of change event after every committed write.
+type EventsConfig struct {
+	// Directory where pending deliveries are kept, so that they survive restart. Required when there are webhooks.
+	QueueDir string `yaml:"queue_dir,omitempty"`
+	// Subscriptions that events are delivered to
+	Webhooks []*WebhookConfig `yaml:"webhooks,omitempty"`
+	// Optional change stream endpoint
+	Stream *StreamConfig `yaml:"stream,omitempty"`
+}
+
+// StreamConfig enables streaming of change events to connected clients, via SSE or WebSocket.
+type StreamConfig struct {
+	// Number of recent events kept in memory, so that reconnecting clients can resume. Default is 1000
+	BufferSize *int `yaml:"buffer_size,omitempty"`
+	// Interval of heartbeat messages sent to idle clients, default is 15s
+	Heartbeat *time.Duration `yaml:"heartbeat,omitempty"`
+}
+
+// WebhookConfig configures single subscription that receives change events by HTTP POST.
+// All patterns use syntax of path.Match, omitted filters match everything.
+type WebhookConfig struct {
+	// Unique name of subscription, also name of its queue directory
+	Name string `yaml:"name"`
+	// URL that events are posted to
+	URL string `yaml:"url"`
+	// Key of HMAC-SHA256 signature of every delivery, deliveries are not signed when omitted
+	Secret *string `yaml:"secret,omitempty"`
+	// Names of backends
+	Backends []string `yaml:"backends,omitempty"`
+	// Names of entities
+	Entities []string `yaml:"entities,omitempty"`
+	// Operations, any of create, update, delete, restore or purge
+	Operations []string `yaml:"operations,omitempty"`
+	// Timeout of single delivery attempt, default is 10s
+	Timeout *time.Duration `yaml:"timeout,omitempty"`
+	// Number of attempts after which delivery is given up, default is 10
+	MaxAttempts *int `yaml:"max_attempts,omitempty"`
+	// Delay before first retry, doubled with every next one up to max_backoff. Default is 1s
+	MinBackoff *time.Duration `yaml:"min_backoff,omitempty"`
+	// Upper bound of delay between retries, default is 10m
+	MaxBackoff *time.Duration `yaml:"max_backoff,omitempty"`
+}
+
+func (ec *EventsConfig) checkAndNormalize() (err error) {
+	if len(ec.Webhooks) == 0 && ec.Stream == nil {
+		return fmt.Errorf("events require at least one webhook or stream")
+	}
+	if ec.Stream != nil {
+		if err = ec.Stream.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	if len(ec.Webhooks) > 0 && len(ec.QueueDir) == 0 {
+		return fmt.Errorf("events.queue_dir is required")
+	}
+	names := make(map[string]bool, len(ec.Webhooks))
+	for i, wh := range ec.Webhooks {
+		if !webhookNameRE.MatchString(wh.Name) {
+			return fmt.Errorf("webhook #%d: invalid name: '%s'", i, wh.Name)
+		}
+		if names[wh.Name] {
+			return fmt.Errorf("webhook #%d: duplicate name: %s", i, wh.Name)
+		}
+		names[wh.Name] = true
+		if len(wh.URL) == 0 {
+			return fmt.Errorf("webhook %s: url is required", wh.Name)
+		}
+		if wh.Backends, err = checkPatterns(wh.Backends); err != nil {
+			return fmt.Errorf("webhook %s: %w", wh.Name, err)
+		}
+		if wh.Entities, err = checkPatterns(wh.Entities); err != nil {
+			return fmt.Errorf("webhook %s: %w", wh.Name, err)
+		}
+		for _, op := range wh.Operations {
+			if !slices.Contains(eventOperations, op) {
+				return fmt.Errorf("webhook %s: unknown operation: %s", wh.Name, op)
+			}
+		}
+		if wh.Timeout == nil {
+			wh.Timeout = &defaultWebhookTimeout
+		}
+		if wh.MaxAttempts == nil {
+			wh.MaxAttempts = &defaultWebhookMaxAttempts
+		}
+		if wh.MinBackoff == nil {
+			wh.MinBackoff = &defaultWebhookMinBackoff
+		}
+		if wh.MaxBackoff == nil {
+			wh.MaxBackoff = &defaultWebhookMaxBackoff
+		}
+		if *wh.Timeout <= 0 || *wh.MaxAttempts < 1 || *wh.MinBackoff <= 0 || *wh.MaxBackoff < *wh.MinBackoff {
+			return fmt.Errorf("webhook %s: invalid delivery settings", wh.Name)
+		}
+	}
+	return nil
+}
+
+func (sc *StreamConfig) checkAndNormalize() error {
+	if sc.BufferSize == nil {
+		sc.BufferSize = &defaultStreamBufferSize
+	}
+	if sc.Heartbeat == nil {
+		sc.Heartbeat = &defaultStreamHeartbeat
+	}
+	if *sc.BufferSize < 1 || *sc.Heartbeat <= 0 {
+		return fmt.Errorf("invalid settings of events.stream")
+	}
+	return nil
+}
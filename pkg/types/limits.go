@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// RateLimitKeyPrincipal limits every principal separately, anonymous callers are keyed by IP address
+	RateLimitKeyPrincipal = "principal"
+	// RateLimitKeyIP limits every client IP address separately
+	RateLimitKeyIP = "ip"
+)
+
+var (
+	defaultRateLimitKey = RateLimitKeyPrincipal
+	defaultQueueTimeout = 5 * time.Second
+)
+
+// RateLimitConfig configures token-bucket rate limit per client.
+type RateLimitConfig struct {
+	// Sustained number of requests per second allowed for single client
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// Maximum number of requests in burst, defaults to requests_per_second rounded up
+	Burst *int `yaml:"burst,omitempty"`
+	// How clients are identified, either "principal" (default) or "ip"
+	Key *string `yaml:"key,omitempty"`
+}
+
+// ConcurrencyConfig caps number of requests that are processed by backend at the same time.
+type ConcurrencyConfig struct {
+	// Maximum number of requests in flight
+	MaxInFlight int `yaml:"max_in_flight"`
+	// How long can request wait for free slot before it's rejected, default is 5s
+	QueueTimeout *time.Duration `yaml:"queue_timeout,omitempty"`
+}
+
+func (rl *RateLimitConfig) checkAndNormalize() error {
+	if rl.RequestsPerSecond <= 0 {
+		return fmt.Errorf("rate_limit.requests_per_second must be positive")
+	}
+	if rl.Burst == nil {
+		rl.Burst = new(int(math.Ceil(rl.RequestsPerSecond)))
+	}
+	if *rl.Burst < 1 {
+		return fmt.Errorf("rate_limit.burst must be positive")
+	}
+	if rl.Key == nil {
+		rl.Key = &defaultRateLimitKey
+	}
+	if *rl.Key != RateLimitKeyPrincipal && *rl.Key != RateLimitKeyIP {
+		return fmt.Errorf("invalid value of rate_limit.key: %s", *rl.Key)
+	}
+	return nil
+}
+
+func (cc *ConcurrencyConfig) checkAndNormalize() error {
+	if cc.MaxInFlight < 1 {
+		return fmt.Errorf("concurrency.max_in_flight must be positive")
+	}
+	if cc.QueueTimeout == nil {
+		cc.QueueTimeout = &defaultQueueTimeout
+	}
+	return nil
+}
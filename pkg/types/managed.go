@@ -0,0 +1,59 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import "fmt"
+
+// ManagedColumns are columns of entity that are filled in by bridge on every write,
+// using server clock and name of authenticated principal. Values supplied by clients are ignored.
+type ManagedColumns struct {
+	// Column set to time of creation
+	CreatedAt *string `yaml:"created_at,omitempty"`
+	// Column set to time of creation and of every update
+	UpdatedAt *string `yaml:"updated_at,omitempty"`
+	// Column set to principal that created row
+	CreatedBy *string `yaml:"created_by,omitempty"`
+	// Column set to principal that created or last updated row
+	UpdatedBy *string `yaml:"updated_by,omitempty"`
+}
+
+// Columns gets names of all configured managed columns.
+func (mc *ManagedColumns) Columns() []string {
+	var res []string
+	if mc != nil {
+		for _, col := range []*string{mc.CreatedAt, mc.UpdatedAt, mc.CreatedBy, mc.UpdatedBy} {
+			if col != nil {
+				res = append(res, *col)
+			}
+		}
+	}
+	return res
+}
+
+func (mc *ManagedColumns) checkAndNormalize() error {
+	seen := map[string]bool{}
+	for _, col := range mc.Columns() {
+		if col == "" {
+			return fmt.Errorf("managed column can't be empty")
+		}
+		if seen[col] {
+			return fmt.Errorf("column %s is managed more than once", col)
+		}
+		seen[col] = true
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	ParamInt     = "int"
+	ParamDecimal = "decimal"
+	ParamString  = "string"
+	ParamDate    = "date"
+	ParamBool    = "bool"
+	ParamList    = "list"
+)
+
+var (
+	paramTypes = []string{ParamInt, ParamDecimal, ParamString, ParamDate, ParamBool, ParamList}
+	paramRefRE = regexp.MustCompile(`^:(\w+)`)
+)
+
+// QueryParam declares named parameter of named query.
+// Parameter is referenced in SQL as :<name>, list parameters are expanded into comma-separated placeholders,
+// so they are meant to be used like "col IN (:ids)".
+type QueryParam struct {
+	// One of int, decimal, string, date, bool or list
+	Type string `yaml:"type"`
+	// Type of list items, defaults to string
+	ItemType *string `yaml:"item_type,omitempty"`
+	// Request is rejected when required parameter is missing
+	Required *bool `yaml:"required,omitempty"`
+	// Value used when parameter is missing, in same format as in request. For lists, items are separated by comma.
+	Default *string `yaml:"default,omitempty"`
+	// Lower bound of value for numbers, of length for strings and of item count for lists
+	Min *float64 `yaml:"min,omitempty"`
+	// Upper bound of value for numbers, of length for strings and of item count for lists
+	Max *float64 `yaml:"max,omitempty"`
+	// Regular expression that string values (or list items) must match
+	Pattern *string `yaml:"pattern,omitempty"`
+	// Allowed values, in same format as in request
+	Enum []string `yaml:"enum,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// Regexp gets compiled Pattern, or nil if there is none
+func (qp *QueryParam) Regexp() *regexp.Regexp {
+	return qp.re
+}
+
+func (qp *QueryParam) checkAndNormalize() (err error) {
+	if !slices.Contains(paramTypes, qp.Type) {
+		return fmt.Errorf("unknown type: %s", qp.Type)
+	}
+	if qp.Type == ParamList {
+		if qp.ItemType == nil {
+			qp.ItemType = new(ParamString)
+		}
+		if *qp.ItemType == ParamList || !slices.Contains(paramTypes, *qp.ItemType) {
+			return fmt.Errorf("invalid item type: %s", *qp.ItemType)
+		}
+	}
+	if qp.Required == nil {
+		qp.Required = &FALSE
+	}
+	if qp.Pattern != nil {
+		if qp.re, err = regexp.Compile(*qp.Pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitNamedSQL splits SQL into text fragments and references to named parameters.
+// There is always one more fragment than references. Quoted strings and identifiers are left intact.
+func SplitNamedSQL(sql string) (texts []string, refs []string) {
+	var (
+		sb    strings.Builder
+		quote byte
+	)
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' && i+1 < len(sql) {
+				sb.WriteByte(c)
+				i++
+				c = sql[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ':' && (i == 0 || sql[i-1] != ':'):
+			if m := paramRefRE.FindStringSubmatch(sql[i:]); m != nil {
+				texts = append(texts, sb.String())
+				refs = append(refs, m[1])
+				sb.Reset()
+				i += len(m[0]) - 1
+				continue
+			}
+		}
+		sb.WriteByte(c)
+	}
+	return append(texts, sb.String()), refs
+}
+
+// checkParams normalizes parameter declarations and ensures that every reference in statements is declared.
+func checkParams(params map[string]*QueryParam, statements ...string) error {
+	for name, p := range params {
+		if p == nil {
+			return fmt.Errorf("parameter %s: missing declaration", name)
+		}
+		if err := p.checkAndNormalize(); err != nil {
+			return fmt.Errorf("parameter %s: %w", name, err)
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	for _, stmt := range statements {
+		_, refs := SplitNamedSQL(stmt)
+		for _, ref := range refs {
+			if _, ok := params[ref]; !ok {
+				return fmt.Errorf("reference to undeclared parameter: %s", ref)
+			}
+		}
+	}
+	return nil
+}
+
+func (nq *NamedQuery) checkAndNormalize() error {
+	if nq.Cache != nil {
+		if err := nq.Cache.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	return checkParams(nq.Params, nq.SQL)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	SearchModeNatural = "natural"
+	SearchModeBoolean = "boolean"
+)
+
+var defaultSearchMode = SearchModeNatural
+
+// SearchConfig enables full-text search within entity.
+type SearchConfig struct {
+	// Columns covered by full-text index, they must match columns of single FULLTEXT index exactly.
+	Columns []string `yaml:"columns"`
+	// Mode used when request doesn't specify one, either "natural" (default) or "boolean"
+	Mode *string `yaml:"mode,omitempty"`
+}
+
+func (sc *SearchConfig) checkAndNormalize() error {
+	if len(sc.Columns) == 0 {
+		return fmt.Errorf("search.columns can't be empty")
+	}
+	for _, col := range sc.Columns {
+		if !identRE.MatchString(col) {
+			return fmt.Errorf("invalid search column: '%s'", col)
+		}
+	}
+	if sc.Mode == nil {
+		sc.Mode = &defaultSearchMode
+	}
+	if !slices.Contains([]string{SearchModeNatural, SearchModeBoolean}, *sc.Mode) {
+		return fmt.Errorf("unsupported search.mode: %s", *sc.Mode)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	SoftDeleteTimestamp = "timestamp"
+	SoftDeleteFlag      = "flag"
+)
+
+var defaultSoftDeleteMarker = SoftDeleteTimestamp
+
+// SoftDeleteConfig makes deletes of entity only mark rows as deleted, instead of removing them.
+type SoftDeleteConfig struct {
+	// Column that marks deleted rows
+	Column string `yaml:"column"`
+	// How rows are marked, either "timestamp" (default), where column holds time of deletion and NULL otherwise,
+	// or "flag", where column holds 1 for deleted rows and 0 otherwise.
+	Marker *string `yaml:"marker,omitempty"`
+}
+
+func (sc *SoftDeleteConfig) checkAndNormalize() error {
+	if len(sc.Column) == 0 {
+		return fmt.Errorf("soft_delete.column can't be empty")
+	}
+	if sc.Marker == nil {
+		sc.Marker = &defaultSoftDeleteMarker
+	}
+	if !slices.Contains([]string{SoftDeleteTimestamp, SoftDeleteFlag}, *sc.Marker) {
+		return fmt.Errorf("unsupported soft_delete.marker: %s", *sc.Marker)
+	}
+	return nil
+}
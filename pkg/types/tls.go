@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+)
+
+const (
+	// ClientAuthRequire rejects connections without valid client certificate
+	ClientAuthRequire = "require"
+	// ClientAuthVerifyIfGiven verifies client certificate only if client sends one
+	ClientAuthVerifyIfGiven = "verify_if_given"
+)
+
+var (
+	defaultTLSMinVersion     = "1.2"
+	defaultClientAuth        = ClientAuthRequire
+	defaultTLSReloadInterval = 30 * time.Second
+
+	tlsVersions = map[string]uint16{
+		"1.0": tls.VersionTLS10,
+		"1.1": tls.VersionTLS11,
+		"1.2": tls.VersionTLS12,
+		"1.3": tls.VersionTLS13,
+	}
+	tlsClientAuths = map[string]tls.ClientAuthType{
+		ClientAuthRequire:       tls.RequireAndVerifyClientCert,
+		ClientAuthVerifyIfGiven: tls.VerifyClientCertIfGiven,
+	}
+)
+
+// TLSConfig configures native HTTPS serving.
+type TLSConfig struct {
+	// Path to PEM-encoded server certificate (chain)
+	CertFile string `yaml:"cert_file"`
+	// Path to PEM-encoded private key of server certificate
+	KeyFile string `yaml:"key_file"`
+	// Minimum accepted TLS version, one of 1.0, 1.1, 1.2 or 1.3. Default is 1.2
+	MinVersion *string `yaml:"min_version,omitempty"`
+	// Optional path to PEM-encoded CA bundle used to verify client certificates (mTLS)
+	ClientCAFile *string `yaml:"client_ca_file,omitempty"`
+	// Client certificate policy when ClientCAFile is set, either "require" (default) or "verify_if_given"
+	ClientAuth *string `yaml:"client_auth,omitempty"`
+	// How often are files checked for changes, default is 30s
+	ReloadInterval *time.Duration `yaml:"reload_interval,omitempty"`
+}
+
+// TLSVersion gets numeric value of MinVersion
+func (tc *TLSConfig) TLSVersion() uint16 {
+	return tlsVersions[*tc.MinVersion]
+}
+
+// ClientAuthType gets client certificate policy
+func (tc *TLSConfig) ClientAuthType() tls.ClientAuthType {
+	if tc.ClientCAFile == nil {
+		return tls.NoClientCert
+	}
+	return tlsClientAuths[*tc.ClientAuth]
+}
+
+func (tc *TLSConfig) checkAndNormalize() error {
+	if len(tc.CertFile) == 0 || len(tc.KeyFile) == 0 {
+		return fmt.Errorf("both tls.cert_file and tls.key_file are required")
+	}
+	if tc.MinVersion == nil {
+		tc.MinVersion = &defaultTLSMinVersion
+	}
+	if _, ok := tlsVersions[*tc.MinVersion]; !ok {
+		return fmt.Errorf("unsupported TLS version: %s", *tc.MinVersion)
+	}
+	if tc.ClientAuth == nil {
+		tc.ClientAuth = &defaultClientAuth
+	}
+	if _, ok := tlsClientAuths[*tc.ClientAuth]; !ok {
+		return fmt.Errorf("invalid value of tls.client_auth: %s", *tc.ClientAuth)
+	}
+	if tc.ReloadInterval == nil {
+		tc.ReloadInterval = &defaultTLSReloadInterval
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Richard Kosegi
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	defaultDateTimeFormat = time.RFC3339Nano
+	defaultDateFormat     = time.DateOnly
+)
+
+// TypeMappingConfig controls how SQL values are represented in JSON payloads.
+type TypeMappingConfig struct {
+	// Render DECIMAL values as strings, so that no precision is lost by clients. Defaults to false.
+	DecimalAsString *bool `yaml:"decimal_as_string,omitempty"`
+	// Go layout of DATETIME and TIMESTAMP values, defaults to RFC 3339 with fractional seconds
+	DateTimeFormat *string `yaml:"datetime_format,omitempty"`
+	// Go layout of DATE values, defaults to 2006-01-02
+	DateFormat *string `yaml:"date_format,omitempty"`
+	// Optional IANA name of time zone that DATETIME and TIMESTAMP values are rendered in.
+	// When omitted, values are rendered in location of driver (see "loc" parameter of DSN).
+	TimeZone *string `yaml:"time_zone,omitempty"`
+
+	loc *time.Location
+}
+
+// Location gets time zone of rendered values, or nil when it's not configured.
+func (tm *TypeMappingConfig) Location() *time.Location {
+	return tm.loc
+}
+
+func (tm *TypeMappingConfig) checkAndNormalize() (err error) {
+	if tm.DecimalAsString == nil {
+		tm.DecimalAsString = &FALSE
+	}
+	if tm.DateTimeFormat == nil {
+		tm.DateTimeFormat = &defaultDateTimeFormat
+	}
+	if tm.DateFormat == nil {
+		tm.DateFormat = &defaultDateFormat
+	}
+	if tm.TimeZone != nil {
+		if tm.loc, err = time.LoadLocation(*tm.TimeZone); err != nil {
+			return fmt.Errorf("type_mapping.time_zone: %w", err)
+		}
+	}
+	return nil
+}
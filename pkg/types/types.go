@@ -22,10 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	ccfg "github.com/rkosegi/go-http-commons/config"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -36,6 +39,7 @@ var (
 	defaultLogFormat = "json"
 	emptyIdMap       = make(map[string]string)
 	beNameRE         = regexp.MustCompile(`^[\w-]{1,63}$`)
+	identRE          = regexp.MustCompile(`^[\w$]{1,64}$`)
 	ErrNoBackend     = errors.New("no backend configured")
 )
 
@@ -47,11 +51,15 @@ type BackendConfig struct {
 	Read   *bool   `yaml:"read,omitempty"`
 	Update *bool   `yaml:"update,omitempty"`
 	Delete *bool   `yaml:"delete,omitempty"`
-	// Optional mapping from entity (table) name to ID column.
+	// Optional mapping from entity name to ID column.
 	// If not specified, then "id" is assumed
 	IdMap *map[string]string `yaml:"id_map,omitempty"`
 	// Named queries that could be executed with optional parameters
-	Queries map[string]string `yaml:"queries"`
+	Queries map[string]*NamedQuery `yaml:"queries"`
+	// Named write commands that could be executed with parameters
+	Commands map[string]*Command `yaml:"commands,omitempty"`
+	// Optional per-entity configuration
+	Entities map[string]*EntityConfig `yaml:"entities,omitempty"`
 	// DDL queries to be executed at start. Be careful here.
 	InitDDLs []string `yaml:"init_ddls,omitempty"`
 
@@ -60,9 +68,155 @@ type BackendConfig struct {
 	ConnMaxLifetime    *time.Duration `yaml:"conn_max_lifetime,omitempty"`
 	ConnMaxIdleTime    *time.Duration `yaml:"conn_max_idle_time,omitempty"`
 
+	// Optional deadline of read operations (lists, lookups and named queries)
+	QueryTimeout *time.Duration `yaml:"query_timeout,omitempty"`
+	// Optional deadline of write operations, including whole transaction of bulk operations
+	WriteTimeout *time.Duration `yaml:"write_timeout,omitempty"`
+
+	// Optional rate limit of requests to this backend, applied per client
+	RateLimit *RateLimitConfig `yaml:"rate_limit,omitempty"`
+	// Optional cap of requests to this backend that are processed concurrently
+	Concurrency *ConcurrencyConfig `yaml:"concurrency,omitempty"`
+	// Whether payloads of writes are validated against JSON schema of entity, defaults to true
+	ValidatePayloads *bool `yaml:"validate_payloads,omitempty"`
+	// Optional overrides of how SQL values are represented in JSON
+	TypeMapping *TypeMappingConfig `yaml:"type_mapping,omitempty"`
+
 	db *sql.DB
 }
 
+// NamedQuery is SQL query saved in configuration.
+// In YAML, it can be given either as plain string with SQL, or as an object.
+type NamedQuery struct {
+	SQL string `yaml:"sql"`
+	// Optional human-readable description, exposed by query discovery
+	Description *string `yaml:"description,omitempty"`
+	// Optional row policy applied on top of result set, makes query scoped to the caller.
+	// See EntityConfig.RowPolicy for syntax.
+	RowPolicy *string `yaml:"row_policy,omitempty"`
+	// Optional deadline of query, overrides query_timeout of backend
+	Timeout *time.Duration `yaml:"timeout,omitempty"`
+	// Optional declaration of named parameters, referenced in SQL as :<name>
+	Params map[string]*QueryParam `yaml:"params,omitempty"`
+	// Optional cache of responses
+	Cache *CacheConfig `yaml:"cache,omitempty"`
+}
+
+// EffectiveTimeout gets timeout of named query, falling back to query timeout of backend.
+func (nq *NamedQuery) EffectiveTimeout(be *BackendConfig) *time.Duration {
+	if nq.Timeout != nil {
+		return nq.Timeout
+	}
+	return be.QueryTimeout
+}
+
+func (nq *NamedQuery) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		nq.SQL = node.Value
+		return nil
+	}
+	type plain NamedQuery
+	return node.Decode((*plain)(nq))
+}
+
+// EntityConfig holds configuration specific to single entity (table).
+type EntityConfig struct {
+	// Optional SQL predicate that every row visible to, or written by caller must satisfy.
+	// It can refer to claims of authenticated principal using ${claims.<name>}
+	// and to name of principal using ${principal}, for example "tenant_id = ${claims.tenant}".
+	// Columns compared for equality with such reference are forced onto written payloads.
+	RowPolicy *string `yaml:"row_policy,omitempty"`
+	// Columns holding sensitive data, their values are masked in audit records.
+	MaskedColumns []string `yaml:"masked_columns,omitempty"`
+	// Optional cache of responses to list requests
+	Cache *CacheConfig `yaml:"cache,omitempty"`
+	// Optional path to JSON schema file, which write payloads must satisfy
+	// on top of schema derived from columns of entity.
+	SchemaFile *string `yaml:"schema_file,omitempty"`
+	// Optional configuration of raw access to binary columns, keyed by column name
+	Blobs map[string]*BlobConfig `yaml:"blobs,omitempty"`
+	// Optional full-text search within entity
+	Search *SearchConfig `yaml:"search,omitempty"`
+	// Optional SELECT statement that makes entity virtual. Instead of table, items are read from result
+	// of this statement, which is wrapped as derived table. Virtual entities are read-only,
+	// ID column (see BackendConfig.IdMap) is optional and without it, items can be only listed.
+	SQL *string `yaml:"sql,omitempty"`
+	// Optional name of table backing entity, when it differs from name of entity in API
+	Table *string `yaml:"table,omitempty"`
+	// Optional mapping from API field name to database column, for columns whose names differ.
+	// All other column names in configuration of backend (ID column, row policy, search, blobs,
+	// masked columns) refer to database columns.
+	Columns map[string]string `yaml:"columns,omitempty"`
+	// Optional soft delete, which makes deletes only mark rows as deleted
+	SoftDelete *SoftDeleteConfig `yaml:"soft_delete,omitempty"`
+	// Optional columns filled in by bridge on writes
+	Managed *ManagedColumns `yaml:"managed,omitempty"`
+}
+
+// TableName gets name of table backing given entity.
+func (ec *EntityConfig) TableName(entity string) string {
+	if ec != nil && ec.Table != nil {
+		return *ec.Table
+	}
+	return entity
+}
+
+// Column gets database column of API field, see Columns.
+func (ec *EntityConfig) Column(field string) string {
+	if ec != nil {
+		if col, ok := ec.Columns[field]; ok {
+			return col
+		}
+	}
+	return field
+}
+
+// Field gets API field name of database column, see Columns.
+func (ec *EntityConfig) Field(column string) string {
+	if ec != nil {
+		for field, col := range ec.Columns {
+			if col == column {
+				return field
+			}
+		}
+	}
+	return column
+}
+
+// Virtual checks whether entity is defined by SQL statement rather than backed by table.
+func (ec *EntityConfig) Virtual() bool {
+	return ec != nil && ec.SQL != nil
+}
+
+// Blob gets configuration of raw access to binary column, falling back to defaults.
+func (ec *EntityConfig) Blob(column string) *BlobConfig {
+	if ec != nil {
+		if bc, ok := ec.Blobs[column]; ok && bc != nil {
+			return bc
+		}
+	}
+	return DefaultBlobConfig()
+}
+
+// Entity gets configuration of given entity, or nil if there is none.
+func (be *BackendConfig) Entity(ent string) *EntityConfig {
+	return be.Entities[ent]
+}
+
+// Shadowed checks whether name is only table backing another, aliased entity.
+// Such name is not addressable, as it would bypass configuration of that entity.
+func (be *BackendConfig) Shadowed(ent string) bool {
+	if be == nil {
+		return false
+	}
+	for name, ec := range be.Entities {
+		if name != ent && ec != nil && ec.Table != nil && *ec.Table == ent {
+			return true
+		}
+	}
+	return false
+}
+
 // IdColumn gets ID column for given entity, see IdMap
 func (be *BackendConfig) IdColumn(ent string) string {
 	if col, ok := (*be.IdMap)[ent]; ok {
@@ -71,6 +225,11 @@ func (be *BackendConfig) IdColumn(ent string) string {
 	return "id"
 }
 
+// IdField gets API field name of ID column of given entity.
+func (be *BackendConfig) IdField(ent string) string {
+	return be.Entity(ent).Field(be.IdColumn(ent))
+}
+
 func (be *BackendConfig) Open(ctx context.Context) error {
 	db, err := sql.Open(*be.Driver, be.DSN)
 	if err != nil {
@@ -147,9 +306,34 @@ type LoggingConfig struct {
 }
 
 type Config struct {
-	Server        ccfg.ServerConfig `yaml:"server"`
-	Backends      Backends          `yaml:"backends"`
-	LoggingConfig *LoggingConfig    `yaml:"logging,omitempty"`
+	Server        ccfg.ServerConfig    `yaml:"server"`
+	Backends      Backends             `yaml:"backends"`
+	LoggingConfig *LoggingConfig       `yaml:"logging,omitempty"`
+	Auth          *AuthConfig          `yaml:"auth,omitempty"`
+	Authorization *AuthorizationConfig `yaml:"authorization,omitempty"`
+	// Optional native HTTPS serving, supersedes server.tls
+	TLS *TLSConfig `yaml:"tls,omitempty"`
+	// Optional audit log of data-changing operations
+	Audit *AuditConfig `yaml:"audit,omitempty"`
+	// Optional publishing of change events to webhooks
+	Events *EventsConfig `yaml:"events,omitempty"`
+	// Optional rate limit of all API requests, applied per client
+	RateLimit *RateLimitConfig `yaml:"rate_limit,omitempty"`
+}
+
+// checkColumnAliases ensures that every alias maps to distinct column, so that names translate both ways.
+func checkColumnAliases(aliases map[string]string) error {
+	seen := make(map[string]string, len(aliases))
+	for field, col := range aliases {
+		if field == "" || col == "" {
+			return fmt.Errorf("empty column alias: '%s' -> '%s'", field, col)
+		}
+		if other, ok := seen[col]; ok {
+			return fmt.Errorf("column %s is aliased by both %s and %s", col, other, field)
+		}
+		seen[col] = field
+	}
+	return nil
 }
 
 // CheckAndNormalize sets any missing optional values and ensures all values are semantically correct.
@@ -199,6 +383,132 @@ func (c *Config) CheckAndNormalize() error {
 		if v.Delete == nil {
 			v.Delete = &FALSE
 		}
+		if v.ValidatePayloads == nil {
+			v.ValidatePayloads = &TRUE
+		}
+		if v.TypeMapping == nil {
+			v.TypeMapping = &TypeMappingConfig{}
+		}
+		if err := v.TypeMapping.checkAndNormalize(); err != nil {
+			return fmt.Errorf("backend %s: %w", k, err)
+		}
+		if v.RateLimit != nil {
+			if err := v.RateLimit.checkAndNormalize(); err != nil {
+				return fmt.Errorf("backend %s: %w", k, err)
+			}
+		}
+		if v.Concurrency != nil {
+			if err := v.Concurrency.checkAndNormalize(); err != nil {
+				return fmt.Errorf("backend %s: %w", k, err)
+			}
+		}
+		for qn, q := range v.Queries {
+			if q == nil || len(q.SQL) == 0 {
+				return fmt.Errorf("empty query %s in backend %s", qn, k)
+			}
+			if err := q.checkAndNormalize(); err != nil {
+				return fmt.Errorf("query %s in backend %s: %w", qn, k, err)
+			}
+		}
+		for en, ec := range v.Entities {
+			if ec == nil {
+				continue
+			}
+			if ec.Cache != nil {
+				if err := ec.Cache.checkAndNormalize(); err != nil {
+					return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+				}
+			}
+			if ec.SchemaFile != nil {
+				if _, err := os.Stat(*ec.SchemaFile); err != nil {
+					return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+				}
+			}
+			if ec.SQL != nil && strings.TrimSpace(*ec.SQL) == "" {
+				return fmt.Errorf("entity %s in backend %s: empty SQL", en, k)
+			}
+			if ec.SQL != nil && ec.Table != nil {
+				return fmt.Errorf("entity %s in backend %s: virtual entity can't have table", en, k)
+			}
+			if ec.Table != nil && *ec.Table == "" {
+				return fmt.Errorf("entity %s in backend %s: empty table name", en, k)
+			}
+			if ec.Table != nil && *ec.Table != en {
+				if _, ok := v.Entities[*ec.Table]; ok {
+					return fmt.Errorf("entity %s in backend %s: table %s is configured as another entity", en, k, *ec.Table)
+				}
+			}
+			if err := checkColumnAliases(ec.Columns); err != nil {
+				return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+			}
+			if ec.Search != nil {
+				if err := ec.Search.checkAndNormalize(); err != nil {
+					return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+				}
+			}
+			if ec.SoftDelete != nil {
+				if err := ec.SoftDelete.checkAndNormalize(); err != nil {
+					return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+				}
+			}
+			if ec.Managed != nil {
+				if err := ec.Managed.checkAndNormalize(); err != nil {
+					return fmt.Errorf("entity %s in backend %s: %w", en, k, err)
+				}
+			}
+			for col, bc := range ec.Blobs {
+				if bc == nil {
+					continue
+				}
+				if err := bc.checkAndNormalize(); err != nil {
+					return fmt.Errorf("blob %s of entity %s in backend %s: %w", col, en, k, err)
+				}
+			}
+		}
+		for cn, cmd := range v.Commands {
+			if cmd == nil {
+				return fmt.Errorf("empty command %s in backend %s", cn, k)
+			}
+			if err := cmd.checkAndNormalize(); err != nil {
+				return fmt.Errorf("command %s in backend %s: %w", cn, k, err)
+			}
+		}
+	}
+	if c.TLS == nil && c.Server.TLS != nil && len(c.Server.TLS.CertFile) > 0 {
+		c.TLS = &TLSConfig{CertFile: c.Server.TLS.CertFile, KeyFile: c.Server.TLS.KeyFile}
+	}
+	if c.TLS != nil {
+		if err := c.TLS.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	if c.Auth != nil {
+		if err := c.Auth.checkAndNormalize(); err != nil {
+			return err
+		}
+		if c.Auth.ClientCert != nil && (c.TLS == nil || c.TLS.ClientCAFile == nil) {
+			return fmt.Errorf("auth.client_cert requires tls.client_ca_file")
+		}
+	}
+	if c.Authorization != nil {
+		if err := c.Authorization.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	if c.RateLimit != nil {
+		if err := c.RateLimit.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	if c.Audit != nil {
+		if err := c.Audit.checkAndNormalize(); err != nil {
+			return err
+		}
+	}
+	if c.Events != nil {
+		if err := c.Events.checkAndNormalize(); err != nil {
+			return err
+		}
 	}
 	if c.LoggingConfig == nil {
 		c.LoggingConfig = &LoggingConfig{Level: &defaultLogLevel, Format: &defaultLogFormat}
@@ -225,3 +535,23 @@ func (h *ErrorWithStatus) Unwrap() error {
 func (h *ErrorWithStatus) Error() string {
 	return h.Msg
 }
+
+// FieldError describes single problem found in payload.
+type FieldError struct {
+	// JSON pointer to offending field within request body
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when payload does not conform to schema of entity.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (v *ValidationError) Error() string {
+	msgs := make([]string, len(v.Errors))
+	for i, fe := range v.Errors {
+		msgs[i] = fe.Field + ": " + fe.Message
+	}
+	return "invalid payload: " + strings.Join(msgs, "; ")
+}